@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/importer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var (
+	billsUpgradeBatchSize uint64
+	billsUpgradeDryRun    bool
+
+	billsImportOwnerID  int64
+	billsImportGroupID  int64
+	billsImportUsers    map[string]int64
+	billsImportCurrency string
+	billsImportDryRun   bool
+)
+
+func init() {
+	billsUpgradeCmd.Flags().Uint64Var(&billsUpgradeBatchSize, "batch-size", models.DefaultBillUpgradeBatchSize, "Bills per transaction")
+	billsUpgradeCmd.Flags().BoolVar(&billsUpgradeDryRun, "dry-run", false, "Check bills without saving")
+
+	billsImportCmd.Flags().Int64Var(&billsImportOwnerID, "owner-id", 0, "User who owns the imported bills")
+	billsImportCmd.Flags().Int64Var(&billsImportGroupID, "group-id", 0, "Group for all imported bills")
+	billsImportCmd.Flags().StringToInt64Var(&billsImportUsers, "user", nil, "Participant name in the file to user ID, e.g. alice=12,bob=13")
+	billsImportCmd.Flags().StringVar(&billsImportCurrency, "currency", "", "Currency of rows without one, default "+string(models.DefaultCurrency))
+	billsImportCmd.Flags().BoolVar(&billsImportDryRun, "dry-run", false, "Check the file without saving")
+	_ = billsImportCmd.MarkFlagRequired("owner-id")
+
+	billsCmd.AddCommand(billsUpgradeCmd)
+	billsCmd.AddCommand(billsImportCmd)
+
+	rootCmd.AddCommand(billsCmd)
+}
+
+var billsCmd = &cobra.Command{
+	Use:   "bills",
+	Short: "Stored bills",
+	Long:  `Maintenance of stored bills`,
+}
+
+var billsUpgradeCmd = &cobra.Command{
+	Use:   "upgrade-schema",
+	Short: "Rewrite stored bills to the latest schema version",
+	Long: fmt.Sprintf(`Rewrite stored bills to the latest schema version (%d).
+
+Bills are read in batches, upgraded version by version and validated.
+Bills that can't be read or fail validation are left as is and listed
+in the report; the command then exits with an error.`, models.CurrentBillSchemaVersion),
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		type Params struct {
+			fx.In
+
+			Ctx     context.Context
+			Service *services.SplitTheBillService
+		}
+
+		return runCmdInAppContainer(
+			func(p Params) error {
+				report, err := p.Service.UpgradeBillsSchema(p.Ctx, models.BillUpgradeOptions{
+					BatchSize: billsUpgradeBatchSize,
+					DryRun:    billsUpgradeDryRun,
+				})
+				if err != nil {
+					return err
+				}
+
+				out := cmd.OutOrStdout()
+				for _, f := range report.Failed {
+					fmt.Fprintf(out, "%s (version %d): %v\n", f.BillID, f.SchemaVersion, f.Err)
+				}
+
+				verb := "upgraded"
+				if billsUpgradeDryRun {
+					verb = "can upgrade"
+				}
+				fmt.Fprintf(out, "checked %d bills, %s %d, failed %d\n", report.Checked, verb, report.Upgraded, len(report.Failed))
+
+				if len(report.Failed) != 0 {
+					return errors.Errorf("%d bills failed to upgrade", len(report.Failed))
+				}
+
+				return nil
+			},
+		)
+	},
+}
+
+var billsImportCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Import bills from a CSV file or a Splitwise export",
+	Long: `Import bills from a CSV file or a Splitwise export.
+
+A plain CSV has the header date,description,payer,amount,participants and
+optional split and currency columns. Participants are separated by ";".
+The split is one of:
+  equal    alice;bob
+  shares   alice:2;bob:1
+  exact    alice:300;bob:200
+  percent  alice:60;bob:40
+The payer is a single name or name:amount pairs for several payers.
+
+A Splitwise export is recognised by its Date,Description,Category,Cost,Currency
+header. Every column after Currency is a participant. Payment rows are
+settlements and are reported as errors.
+
+Names are mapped to users with --user. A number in place of a name is a user ID.
+Rows that fail validation are reported with their line numbers. The other rows
+are saved in one transaction and the command exits with an error.
+Every row is saved once. Running the same file again skips rows that are already imported.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+
+		opts := importer.BillCSVOptions{
+			Users:   make(map[string]models.UserID, len(billsImportUsers)),
+			GroupID: models.GroupID(billsImportGroupID),
+		}
+		for name, id := range billsImportUsers {
+			opts.Users[strings.ToLower(strings.TrimSpace(name))] = models.UserID(id)
+		}
+		if billsImportCurrency != "" {
+			currency, err := models.ParseCurrency(billsImportCurrency)
+			if err != nil {
+				return errors.Wrap(err, "--currency")
+			}
+			opts.Currency = currency
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close()
+
+		parsed, err := importer.ParseBillsCSV(f, opts)
+		if err != nil {
+			return errors.Wrap(err, path)
+		}
+
+		type Params struct {
+			fx.In
+
+			Ctx     context.Context
+			Service *services.BillImportService
+		}
+
+		return runCmdInAppContainer(
+			func(p Params) error {
+				report, err := p.Service.ImportBills(p.Ctx, models.UserID(billsImportOwnerID), parsed.Bills, billsImportDryRun)
+				if err != nil {
+					return err
+				}
+
+				out := cmd.OutOrStdout()
+				for _, e := range parsed.Errors {
+					fmt.Fprintf(out, "%s:%v\n", path, e)
+				}
+
+				if report.DryRun {
+					fmt.Fprintf(out, "can import %d bills, already imported %d, failed %d\n", report.Pending, report.Skipped, len(parsed.Errors))
+				} else {
+					fmt.Fprintf(out, "imported %d bills, already imported %d, failed %d\n", len(report.Imported), report.Skipped, len(parsed.Errors))
+				}
+
+				if len(parsed.Errors) != 0 {
+					return errors.Errorf("%d rows failed to import", len(parsed.Errors))
+				}
+
+				return nil
+			},
+		)
+	},
+}
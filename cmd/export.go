@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/exporter"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var (
+	exportUserID int64
+	exportFormat string
+	exportReport string
+	exportFrom   string
+	exportTo     string
+	exportOutput string
+)
+
+func init() {
+	exportCmd.Flags().Int64Var(&exportUserID, "user-id", 0, "User whose data is exported")
+	exportCmd.Flags().StringVar(&exportFormat, "format", exporter.FormatCSV, "File format: csv, json or pdf")
+	exportCmd.Flags().StringVar(&exportReport, "report", exporter.ReportLedger, "Report: ledger or bills")
+	exportCmd.Flags().StringVar(&exportFrom, "from", "", "First day of the period, YYYY-MM-DD")
+	exportCmd.Flags().StringVar(&exportTo, "to", "", "Last day of the period inclusive, YYYY-MM-DD")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Output file, default stdout")
+	_ = exportCmd.MarkFlagRequired("user-id")
+
+	rootCmd.AddCommand(exportCmd)
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a user's ledger or bills to CSV, JSON or PDF",
+	Long: `Export a user's ledger or bills to CSV, JSON or PDF.
+
+The ledger report lists accounting entries with a running balance per currency.
+The bills report lists items, shares, payments and the resulting debts of every
+bill the user takes part in. Dates are UTC days, both ends inclusive.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := exporter.NormalizeFormat(exportFormat)
+		if err != nil {
+			return err
+		}
+
+		period := exporter.Period{UserID: models.UserID(exportUserID)}
+		if period.From, err = parseExportDay(exportFrom); err != nil {
+			return errors.Wrap(err, "--from")
+		}
+		if period.To, err = parseExportDay(exportTo); err != nil {
+			return errors.Wrap(err, "--to")
+		}
+		// Period полуоткрытый, а в CLI день "по" входит в отчёт.
+		if !period.To.IsZero() {
+			period.To = period.To.AddDate(0, 0, 1)
+		}
+
+		type Params struct {
+			fx.In
+
+			Ctx     context.Context
+			Service *services.ExportService
+		}
+
+		return runCmdInAppContainer(
+			func(p Params) error {
+				return writeExport(cmd.OutOrStdout(), format, func(w exporter.Writer) error {
+					return p.Service.Export(p.Ctx, exportReport, period, w)
+				})
+			},
+		)
+	},
+}
+
+func parseExportDay(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, s)
+	return t, errors.WithStack(err)
+}
+
+func writeExport(stdout io.Writer, format string, export func(exporter.Writer) error) error {
+	out := stdout
+	if exportOutput != "" {
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close()
+
+		out = f
+	}
+
+	w := bufio.NewWriter(out)
+	fw, err := exporter.NewWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	if err := export(fw); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if f, ok := out.(*os.File); ok && exportOutput != "" {
+		if err := f.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+		fmt.Fprintf(os.Stderr, "exported to %s\n", exportOutput)
+	}
+
+	return nil
+}
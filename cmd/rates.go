@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/importer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var ratesFormat string
+
+func init() {
+	ratesImportCmd.Flags().StringVar(&ratesFormat, "format", "", "File format: csv or json, default from file extension")
+
+	ratesCmd.AddCommand(ratesImportCmd)
+
+	rootCmd.AddCommand(ratesCmd)
+}
+
+var ratesCmd = &cobra.Command{
+	Use:   "rates",
+	Short: "Exchange rates",
+	Long:  `Manage exchange rates used for currency conversion`,
+}
+
+var ratesImportCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Import dated exchange rates from a CSV or JSON file",
+	Long: `Import dated exchange rates from a CSV or JSON file.
+
+CSV has the header date,base,quote,rate; JSON is an array of
+{"date": "2023-03-01", "base": "USD", "quote": "RUB", "rate": "75.43"}.
+A rate means 1 base = rate quote. Rates for the same pair and date are overwritten.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+
+		format := ratesFormat
+		if format == "" {
+			format = strings.TrimPrefix(filepath.Ext(path), ".")
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close()
+
+		rates, err := importer.ParseRates(f, format)
+		if err != nil {
+			return errors.Wrap(err, path)
+		}
+
+		type Params struct {
+			fx.In
+
+			Ctx     context.Context
+			Service *services.ExchangeRateService
+		}
+
+		return runCmdInAppContainer(
+			func(p Params) error {
+				if err := p.Service.ImportRates(p.Ctx, rates); err != nil {
+					return err
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "imported %d rates\n", len(rates))
+				return nil
+			},
+		)
+	},
+}
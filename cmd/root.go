@@ -24,10 +24,11 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		// Start main app
 		fxapp.NewApp(
-			fxapp.Module,
+			fxhttp.Module,
 			// Запускаем те сервисы, которые составляю наше приложение
 			fx.Invoke(func(fxhttp.HTTPServer) {}),
 			fx.Invoke(func(fxhttp.ConnectServer) {}),
+			fx.Invoke(func(fxhttp.InternalConnectServer) {}),
 		).Run()
 	},
 }
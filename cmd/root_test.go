@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/storage/pgsql"
 	"github.com/SlamJam/dolgovnya-backend/internal/bootstrap/fxapp"
 	"github.com/doug-martin/goqu/v9"
+	"github.com/rs/zerolog"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
@@ -68,7 +72,7 @@ func TestSQL(t *testing.T) {
 }
 
 func NewMoneyFromInt(amount int64) models.Money {
-	return models.Money{models.NewMoney().Add(decimal.NewFromInt(amount))}
+	return models.Money{Decimal: models.NewMoney().Add(decimal.NewFromInt(amount))}
 }
 
 func populateFromApp(t *testing.T, pointers ...any) error {
@@ -112,7 +116,7 @@ func TestCreateBill(t *testing.T) {
 			{
 				Title:       "Торт",
 				PricePerOne: NewMoneyFromInt(100),
-				Quantity:    1,
+				Quantity:    decimal.NewFromInt(1),
 				Shares: []models.BillShare{
 					{UserID: 1, Share: 1},
 					{UserID: 2, Share: 1},
@@ -182,3 +186,255 @@ func TestUserAccount(t *testing.T) {
 
 	fmt.Println(acc)
 }
+
+// Уникальная часть имён тестовых пользователей: тесты не мешают друг другу
+// и повторным запускам на той же базе.
+var (
+	testRunID     = time.Now().UnixNano()
+	testUserCount atomic.Int64
+)
+
+// Новые пользователи для теста. Без доступной базы тест пропускается.
+func createTestUsers(t *testing.T, s *pgsql.Storage, titles ...string) []models.UserID {
+	t.Helper()
+
+	ctx := context.Background()
+	if s == nil {
+		t.Skip("database is not available")
+	}
+	if err := s.Ping(ctx); err != nil {
+		t.Skipf("database is not available: %v", err)
+	}
+
+	userIDs := make([]models.UserID, 0, len(titles))
+	for _, title := range titles {
+		title = fmt.Sprintf("%s %s %d-%d", t.Name(), title, testRunID, testUserCount.Add(1))
+		userID, err := s.CreateUser(ctx, title)
+		require.NoError(t, err)
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs
+}
+
+func TestConcurrentSettlements(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var s *pgsql.Storage
+	require.NoError(
+		populateFromApp(t, &s),
+	)
+
+	users := createTestUsers(t, s, "alice", "bob")
+	alice, bob := users[0], users[1]
+
+	// Алиса заплатила за Боба 100.
+	_, err := s.SaveSplittedBill(ctx, alice, models.Bill{
+		Items: []models.BillItem{{
+			Title:       "Ужин",
+			PricePerOne: NewMoneyFromInt(100),
+			Quantity:    decimal.NewFromInt(1),
+			Shares:      []models.BillShare{{UserID: bob, Share: 1}},
+		}},
+		Payments: []models.BillPayment{{UserID: alice, Amount: NewMoneyFromInt(100)}},
+	})
+	require.NoError(err)
+
+	log := zerolog.Nop()
+	service := services.NewSettlementService(s, &log)
+
+	// Десять параллельных погашений по 30: долг покрывают только три.
+	amount := NewMoneyFromInt(30)
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, errs[i] = service.RecordSettlement(ctx, bob, bob, alice, models.DefaultCurrency, &amount)
+		}(i)
+	}
+	wg.Wait()
+
+	saved := 0
+	for _, err := range errs {
+		if err == nil {
+			saved++
+			continue
+		}
+		require.ErrorIs(err, models.ErrSettlementExceedsDebt)
+	}
+	require.Equal(3, saved)
+
+	balances, err := s.GetUserBalances(ctx, bob)
+	require.NoError(err)
+	require.True(balances[models.DefaultCurrency][alice].Equal(decimal.NewFromInt(-10)), "balance %s", balances[models.DefaultCurrency][alice])
+}
+
+func TestConcurrentGroupSettlements(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var s *pgsql.Storage
+	require.NoError(
+		populateFromApp(t, &s),
+	)
+
+	users := createTestUsers(t, s, "alice", "bob")
+	alice, bob := users[0], users[1]
+
+	log := zerolog.Nop()
+	groups := services.NewGroupService(s, &log)
+
+	group, err := groups.CreateGroup(ctx, alice, "trip", []models.UserID{bob})
+	require.NoError(err)
+
+	// Алиса заплатила за Боба 100 в группе.
+	_, err = s.SaveSplittedBill(ctx, alice, models.Bill{
+		Items: []models.BillItem{{
+			Title:       "Ужин",
+			PricePerOne: NewMoneyFromInt(100),
+			Quantity:    decimal.NewFromInt(1),
+			Shares:      []models.BillShare{{UserID: bob, Share: 1}},
+		}},
+		Payments: []models.BillPayment{{UserID: alice, Amount: NewMoneyFromInt(100)}},
+		GroupID:  group.ID,
+	})
+	require.NoError(err)
+
+	// Параллельно с погашениями Алиса пытается удалить Боба: пока долг
+	// не погашен, удаление не проходит.
+	amount := NewMoneyFromInt(30)
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, errs[i] = groups.RecordSettlement(ctx, bob, group.ID, bob, alice, models.DefaultCurrency, &amount)
+		}(i)
+	}
+	_, removeErr := groups.RemoveMember(ctx, group.ID, bob)
+	wg.Wait()
+
+	require.ErrorIs(removeErr, models.ErrMemberHasBalance)
+
+	saved := 0
+	for _, err := range errs {
+		if err == nil {
+			saved++
+			continue
+		}
+		require.ErrorIs(err, models.ErrSettlementExceedsDebt)
+	}
+	require.Equal(3, saved)
+
+	balances, err := groups.GetBalances(ctx, group.ID)
+	require.NoError(err)
+	require.True(balances[models.DefaultCurrency][bob].Equal(decimal.NewFromInt(-10)), "balance %s", balances[models.DefaultCurrency][bob])
+}
+
+func TestMergedUserIsRejected(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var s *pgsql.Storage
+	require.NoError(
+		populateFromApp(t, &s),
+	)
+
+	users := createTestUsers(t, s, "alice", "bob", "bob duplicate")
+	alice, bob, duplicate := users[0], users[1], users[2]
+
+	dinner := func(debtor models.UserID) models.Bill {
+		return models.Bill{
+			Items: []models.BillItem{{
+				Title:       "Ужин",
+				PricePerOne: NewMoneyFromInt(100),
+				Quantity:    decimal.NewFromInt(1),
+				Shares:      []models.BillShare{{UserID: debtor, Share: 1}},
+			}},
+			Payments: []models.BillPayment{{UserID: alice, Amount: NewMoneyFromInt(100)}},
+		}
+	}
+
+	billID, err := s.SaveSplittedBill(ctx, alice, dinner(duplicate))
+	require.NoError(err)
+
+	merge, err := s.MergeUsers(ctx, bob, duplicate, false)
+	require.NoError(err)
+	require.NotZero(merge.ID)
+
+	user, err := s.GetUser(ctx, duplicate)
+	require.NoError(err)
+	require.Equal(bob, user.MergedInto)
+
+	// Долг дубля перешёл к Бобу.
+	balances, err := s.GetUserBalances(ctx, bob)
+	require.NoError(err)
+	require.True(balances[models.DefaultCurrency][alice].Equal(decimal.NewFromInt(-100)))
+
+	// Дубля больше нельзя указать ни в счёте, ни в погашении, ни в группе.
+	_, err = s.SaveSplittedBill(ctx, alice, dinner(duplicate))
+	require.ErrorIs(err, models.ErrUserMerged)
+
+	bills, err := s.GetBills(ctx, []models.BillID{billID})
+	require.NoError(err)
+	_, err = s.UpdateSplittedBill(ctx, alice, billID, bills[0].Revision, dinner(duplicate))
+	require.ErrorIs(err, models.ErrUserMerged)
+
+	log := zerolog.Nop()
+	amount := NewMoneyFromInt(10)
+	_, _, err = services.NewSettlementService(s, &log).RecordSettlement(ctx, alice, duplicate, alice, models.DefaultCurrency, &amount)
+	require.ErrorIs(err, models.ErrUserMerged)
+
+	_, err = services.NewGroupService(s, &log).CreateGroup(ctx, alice, "trip", []models.UserID{duplicate})
+	require.ErrorIs(err, models.ErrUserMerged)
+
+	_, err = s.MergeUsers(ctx, alice, duplicate, false)
+	require.ErrorIs(err, models.ErrUserMerged)
+}
+
+func TestDeactivatedUserIsRejected(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var s *pgsql.Storage
+	require.NoError(
+		populateFromApp(t, &s),
+	)
+
+	users := createTestUsers(t, s, "alice", "bob")
+	alice, bob := users[0], users[1]
+
+	dinner := models.Bill{
+		Items: []models.BillItem{{
+			Title:       "Ужин",
+			PricePerOne: NewMoneyFromInt(100),
+			Quantity:    decimal.NewFromInt(1),
+			Shares:      []models.BillShare{{UserID: bob, Share: 1}},
+		}},
+		Payments: []models.BillPayment{{UserID: alice, Amount: NewMoneyFromInt(100)}},
+	}
+
+	_, err := s.SaveSplittedBill(ctx, alice, dinner)
+	require.NoError(err)
+
+	_, err = s.DeactivateUser(ctx, bob, time.Now())
+	require.NoError(err)
+
+	// В новые счета и группы Боба указать нельзя.
+	_, err = s.SaveSplittedBill(ctx, alice, dinner)
+	require.ErrorIs(err, models.ErrUserDeactivated)
+
+	log := zerolog.Nop()
+	_, err = services.NewGroupService(s, &log).CreateGroup(ctx, alice, "trip", []models.UserID{bob})
+	require.ErrorIs(err, models.ErrUserDeactivated)
+
+	// А старый долг закрыть можно.
+	_, remaining, err := services.NewSettlementService(s, &log).RecordSettlement(ctx, alice, bob, alice, models.DefaultCurrency, nil)
+	require.NoError(err)
+	require.True(remaining.IsZero())
+}
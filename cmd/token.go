@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var tokenUserID int64
+var tokenTTL time.Duration
+
+func init() {
+	tokenIssueCmd.Flags().Int64Var(&tokenUserID, "user-id", 0, "User ID to issue the token for")
+	tokenIssueCmd.Flags().DurationVar(&tokenTTL, "ttl", 0, "Token lifetime, default from config")
+	_ = tokenIssueCmd.MarkFlagRequired("user-id")
+
+	tokenCmd.AddCommand(tokenIssueCmd)
+
+	rootCmd.AddCommand(tokenCmd)
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Auth tokens",
+	Long:  `Manage JWT auth tokens for local development and tests`,
+}
+
+var tokenIssueCmd = &cobra.Command{
+	Use:   "issue",
+	Short: "Issue a signed JWT for a user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if tokenUserID <= 0 {
+			return errors.New("--user-id must be positive")
+		}
+
+		return runCmdInAppContainer(
+			func(issuer *auth.Issuer) error {
+				token, err := issuer.Issue(models.UserID(tokenUserID), tokenTTL)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), token)
+				return nil
+			},
+		)
+	},
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var (
+	usersMergeInto   int64
+	usersMergeFrom   int64
+	usersMergeDryRun bool
+)
+
+func init() {
+	usersMergeCmd.Flags().Int64Var(&usersMergeInto, "into", 0, "User ID that stays")
+	usersMergeCmd.Flags().Int64Var(&usersMergeFrom, "from", 0, "Duplicate user ID to merge away")
+	usersMergeCmd.Flags().BoolVar(&usersMergeDryRun, "dry-run", false, "Count what would be moved without saving")
+	_ = usersMergeCmd.MarkFlagRequired("into")
+	_ = usersMergeCmd.MarkFlagRequired("from")
+
+	usersCmd.AddCommand(usersMergeCmd)
+
+	rootCmd.AddCommand(usersCmd)
+}
+
+var usersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "Users",
+	Long:  `Maintenance of users`,
+}
+
+var usersMergeCmd = &cobra.Command{
+	Use:   "merge",
+	Short: "Merge a duplicate user into another user",
+	Long: `Merge a duplicate user into another user in one transaction.
+
+Accounting entries, settlements, bills (including their revisions) and group
+memberships of --from are moved to --into. Entries and settlements between
+the two users would become debts to oneself, so they are dropped.
+The merge is recorded in the user_merges audit table.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if usersMergeInto <= 0 || usersMergeFrom <= 0 {
+			return errors.New("--into and --from must be positive")
+		}
+
+		type Params struct {
+			fx.In
+
+			Ctx     context.Context
+			Service *services.UserService
+		}
+
+		return runCmdInAppContainer(
+			func(p Params) error {
+				merge, err := p.Service.MergeUsers(p.Ctx, models.UserID(usersMergeInto), models.UserID(usersMergeFrom), usersMergeDryRun)
+				if err != nil {
+					return err
+				}
+
+				out := cmd.OutOrStdout()
+				if merge.DryRun {
+					fmt.Fprintf(out, "dry run, nothing saved\n")
+				} else {
+					fmt.Fprintf(out, "merge #%d: %s into %s\n", merge.ID, merge.SourceID, merge.TargetID)
+				}
+				fmt.Fprintf(out, "entries: moved %d, dropped %d\n", merge.EntriesMoved, merge.EntriesDropped)
+				fmt.Fprintf(out, "settlements: moved %d, dropped %d\n", merge.SettlementsMoved, merge.SettlementsDropped)
+				fmt.Fprintf(out, "bills rewritten %d, revisions rewritten %d\n", merge.BillsRewritten, merge.RevisionsRewritten)
+				fmt.Fprintf(out, "group memberships: moved %d, merged %d\n", merge.MembershipsMoved, merge.MembershipsMerged)
+
+				return nil
+			},
+		)
+	},
+}
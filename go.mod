@@ -8,6 +8,7 @@ require (
 	github.com/bufbuild/connect-go v1.5.2
 	github.com/cenkalti/backoff/v4 v4.2.0
 	github.com/doug-martin/goqu/v9 v9.18.0
+	github.com/golang-jwt/jwt/v5 v5.0.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.15.2
 	github.com/jackc/pgx/v5 v5.3.1
 	github.com/jmoiron/sqlx v1.3.5
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNoUserInContext = errors.New("no authenticated user in context")
+)
+
+type userIDCtxKey struct{}
+
+func WithUserID(ctx context.Context, userID models.UserID) context.Context {
+	return context.WithValue(ctx, userIDCtxKey{}, userID)
+}
+
+func UserIDFromCtx(ctx context.Context) (models.UserID, error) {
+	userID, ok := ctx.Value(userIDCtxKey{}).(models.UserID)
+	if !ok {
+		return 0, ErrNoUserInContext
+	}
+
+	return userID, nil
+}
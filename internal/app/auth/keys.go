@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnsupportedKey = errors.New("unsupported key")
+	ErrInvalidPEM     = errors.New("no PEM block found")
+)
+
+// Ключ проверки подписи. KeyID пустой, если ключ задан без kid.
+type verificationKey struct {
+	KeyID string
+	Key   crypto.PublicKey
+}
+
+// Публичный ключ RS256/EdDSA в PEM.
+func parsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKey, "%T", key)
+	}
+}
+
+// Приватный ключ RS256/EdDSA в PEM (PKCS#8 или PKCS#1 для RSA).
+func parsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidPEM
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedKey, "%T", key)
+	}
+}
+
+// https://www.rfc-editor.org/rfc/rfc7517
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// OKP
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	// oct
+	K string `json:"k"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// Ключи из JWKS-файла. Симметричные (oct) возвращаются отдельно, для HS256.
+func loadJWKSFile(path string) (public []verificationKey, secrets map[string][]byte, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	return parseJWKS(data)
+}
+
+func parseJWKS(data []byte) (public []verificationKey, secrets map[string][]byte, err error) {
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, nil, errors.Wrap(err, "fail parse JWKS")
+	}
+
+	secrets = map[string][]byte{}
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		switch k.Kty {
+		case "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(k.N)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "key %d: n", i)
+			}
+
+			e, err := base64.RawURLEncoding.DecodeString(k.E)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "key %d: e", i)
+			}
+
+			public = append(public, verificationKey{
+				KeyID: k.Kid,
+				Key: &rsa.PublicKey{
+					N: new(big.Int).SetBytes(n),
+					E: int(new(big.Int).SetBytes(e).Int64()),
+				},
+			})
+		case "OKP":
+			if k.Crv != "Ed25519" {
+				return nil, nil, errors.Wrapf(ErrUnsupportedKey, "key %d: curve %q", i, k.Crv)
+			}
+
+			x, err := base64.RawURLEncoding.DecodeString(k.X)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "key %d: x", i)
+			}
+
+			if len(x) != ed25519.PublicKeySize {
+				return nil, nil, errors.Wrapf(ErrUnsupportedKey, "key %d: bad Ed25519 key size", i)
+			}
+
+			public = append(public, verificationKey{
+				KeyID: k.Kid,
+				Key:   ed25519.PublicKey(x),
+			})
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(k.K)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "key %d: k", i)
+			}
+
+			secrets[k.Kid] = secret
+		default:
+			return nil, nil, errors.Wrapf(ErrUnsupportedKey, "key %d: kty %q", i, k.Kty)
+		}
+	}
+
+	return public, secrets, nil
+}
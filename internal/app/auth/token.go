@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultTokenTTL = 24 * time.Hour
+)
+
+var (
+	ErrNoKeys         = errors.New("no keys configured")
+	ErrUnknownKey     = errors.New("unknown signing key")
+	ErrNoExpiration   = errors.New("token has no expiration")
+	ErrInvalidSubject = errors.New("subject is not a user id")
+)
+
+var supportedMethods = []string{
+	jwt.SigningMethodHS256.Alg(),
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
+// Проверяет токены и достаёт из них пользователя. UserID лежит в sub.
+type Verifier struct {
+	issuer string
+	// kid -> секрет. Секрет из конфига лежит под пустым kid.
+	secrets map[string][]byte
+	keys    []verificationKey
+}
+
+func NewVerifier(cfg config.Config) (*Verifier, error) {
+	v := &Verifier{
+		issuer:  cfg.Auth.Issuer,
+		secrets: map[string][]byte{},
+	}
+
+	if cfg.Auth.HMACSecret != "" {
+		v.secrets[""] = []byte(cfg.Auth.HMACSecret)
+	}
+
+	for i, data := range cfg.Auth.PublicKeysPEM {
+		key, err := parsePublicKeyPEM([]byte(data))
+		if err != nil {
+			return nil, errors.Wrapf(err, "public key at index %d", i)
+		}
+
+		v.keys = append(v.keys, verificationKey{Key: key})
+	}
+
+	if cfg.Auth.JWKSFile != "" {
+		keys, secrets, err := loadJWKSFile(cfg.Auth.JWKSFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "JWKS file %s", cfg.Auth.JWKSFile)
+		}
+
+		v.keys = append(v.keys, keys...)
+		for kid, secret := range secrets {
+			v.secrets[kid] = secret
+		}
+	}
+
+	if len(v.secrets) == 0 && len(v.keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return v, nil
+}
+
+func (v *Verifier) Verify(tokenString string) (models.UserID, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(supportedMethods),
+	}
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+
+	var claims jwt.RegisteredClaims
+	if _, err := jwt.ParseWithClaims(tokenString, &claims, v.keyFunc, opts...); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if claims.ExpiresAt == nil {
+		return 0, ErrNoExpiration
+	}
+
+	id, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidSubject
+	}
+
+	return models.UserID(id), nil
+}
+
+func (v *Verifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if secret, ok := v.secrets[kid]; ok {
+			return secret, nil
+		}
+	case *jwt.SigningMethodRSA:
+		if key := v.findKey(kid, func(k crypto.PublicKey) bool { _, ok := k.(*rsa.PublicKey); return ok }); key != nil {
+			return key, nil
+		}
+	case *jwt.SigningMethodEd25519:
+		if key := v.findKey(kid, func(k crypto.PublicKey) bool { _, ok := k.(ed25519.PublicKey); return ok }); key != nil {
+			return key, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrUnknownKey, "alg %s, kid %q", token.Method.Alg(), kid)
+}
+
+// Ключ подходящего типа. Без kid в токене подходит только единственный такой ключ.
+func (v *Verifier) findKey(kid string, fits func(crypto.PublicKey) bool) crypto.PublicKey {
+	var found []crypto.PublicKey
+	for _, k := range v.keys {
+		if !fits(k.Key) {
+			continue
+		}
+
+		if kid != "" && k.KeyID == kid {
+			return k.Key
+		}
+
+		found = append(found, k.Key)
+	}
+
+	if kid == "" && len(found) == 1 {
+		return found[0]
+	}
+
+	return nil
+}
+
+// Выпускает токены. Нужен для локальной разработки и тестов.
+type Issuer struct {
+	issuer string
+	keyID  string
+	ttl    time.Duration
+	method jwt.SigningMethod
+	key    interface{}
+}
+
+func NewIssuer(cfg config.Config) (*Issuer, error) {
+	i := &Issuer{
+		issuer: cfg.Auth.Issuer,
+		keyID:  cfg.Auth.SigningKeyID,
+		ttl:    cfg.Auth.TokenTTL,
+	}
+
+	if i.ttl == 0 {
+		i.ttl = defaultTokenTTL
+	}
+
+	if cfg.Auth.SigningKeyFile == "" {
+		if cfg.Auth.HMACSecret == "" {
+			return nil, ErrNoKeys
+		}
+
+		i.method = jwt.SigningMethodHS256
+		i.key = []byte(cfg.Auth.HMACSecret)
+
+		return i, nil
+	}
+
+	data, err := os.ReadFile(cfg.Auth.SigningKeyFile)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	signer, err := parsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "signing key %s", cfg.Auth.SigningKeyFile)
+	}
+
+	switch signer.(type) {
+	case *rsa.PrivateKey:
+		i.method = jwt.SigningMethodRS256
+	case ed25519.PrivateKey:
+		i.method = jwt.SigningMethodEdDSA
+	}
+	i.key = signer
+
+	return i, nil
+}
+
+// Токен для пользователя. Если ttl нулевой, то берётся из конфига.
+func (i *Issuer) Issue(userID models.UserID, ttl time.Duration) (string, error) {
+	if ttl == 0 {
+		ttl = i.ttl
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(i.method, jwt.RegisteredClaims{
+		Issuer:    i.issuer,
+		Subject:   strconv.FormatInt(int64(userID), 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	})
+
+	if i.keyID != "" {
+		token.Header["kid"] = i.keyID
+	}
+
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func privatePEM(t *testing.T, key interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestHS256(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Config{Auth: config.Auth{HMACSecret: "secret", Issuer: "dolgovnya"}}
+
+	issuer, err := NewIssuer(cfg)
+	require.NoError(err)
+	verifier, err := NewVerifier(cfg)
+	require.NoError(err)
+
+	token, err := issuer.Issue(42, time.Minute)
+	require.NoError(err)
+
+	userID, err := verifier.Verify(token)
+	require.NoError(err)
+	require.Equal(models.UserID(42), userID)
+
+	expired, err := issuer.Issue(42, -time.Minute)
+	require.NoError(err)
+	_, err = verifier.Verify(expired)
+	require.Error(err)
+
+	other, err := NewVerifier(config.Config{Auth: config.Auth{HMACSecret: "other"}})
+	require.NoError(err)
+	_, err = other.Verify(token)
+	require.Error(err)
+
+	wrongIssuer, err := NewVerifier(config.Config{Auth: config.Auth{HMACSecret: "secret", Issuer: "someone"}})
+	require.NoError(err)
+	_, err = wrongIssuer.Verify(token)
+	require.Error(err)
+}
+
+func TestEdDSAFromPEM(t *testing.T) {
+	require := require.New(t)
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(err)
+
+	issuer, err := NewIssuer(config.Config{Auth: config.Auth{
+		SigningKeyFile: writeFile(t, "ed25519.pem", privatePEM(t, priv)),
+	}})
+	require.NoError(err)
+
+	verifier, err := NewVerifier(config.Config{Auth: config.Auth{
+		PublicKeysPEM: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))},
+	}})
+	require.NoError(err)
+
+	token, err := issuer.Issue(7, time.Minute)
+	require.NoError(err)
+
+	userID, err := verifier.Verify(token)
+	require.NoError(err)
+	require.Equal(models.UserID(7), userID)
+}
+
+func TestRS256FromJWKS(t *testing.T) {
+	require := require.New(t)
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(err)
+
+	set, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(priv.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(priv.E)).Bytes()),
+		}},
+	})
+	require.NoError(err)
+
+	issuer, err := NewIssuer(config.Config{Auth: config.Auth{
+		SigningKeyFile: writeFile(t, "rsa.pem", privatePEM(t, priv)),
+		SigningKeyID:   "key-1",
+	}})
+	require.NoError(err)
+
+	verifier, err := NewVerifier(config.Config{Auth: config.Auth{
+		JWKSFile: writeFile(t, "jwks.json", set),
+	}})
+	require.NoError(err)
+
+	token, err := issuer.Issue(3, time.Minute)
+	require.NoError(err)
+
+	userID, err := verifier.Verify(token)
+	require.NoError(err)
+	require.Equal(models.UserID(3), userID)
+
+	// Токен HS256 при наличии только RSA-ключей не принимается.
+	hsIssuer, err := NewIssuer(config.Config{Auth: config.Auth{HMACSecret: "secret"}})
+	require.NoError(err)
+	hsToken, err := hsIssuer.Issue(3, time.Minute)
+	require.NoError(err)
+	_, err = verifier.Verify(hsToken)
+	require.ErrorIs(err, ErrUnknownKey)
+}
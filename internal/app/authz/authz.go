@@ -0,0 +1,224 @@
+// Правила доступа к счетам и балансам. Решения принимаются только по
+// переданным данным, без похода в хранилище.
+package authz
+
+import (
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+type Policy struct {
+	deleteGracePeriod time.Duration
+	now               func() time.Time
+}
+
+func NewPolicy(cfg config.Config) *Policy {
+	return &Policy{
+		deleteGracePeriod: cfg.Authz.DeleteGracePeriod,
+		now:               time.Now,
+	}
+}
+
+func deny(format string, args ...interface{}) error {
+	return errors.Wrapf(ErrPermissionDenied, format, args...)
+}
+
+// С кем пользователь уже связан: контрагенты по проводкам, участники общих
+// групп и заведённые им заглушки. Собирает services.UserService.GetContacts.
+type Contacts map[models.UserID]struct{}
+
+func NewContacts(userIDs ...models.UserID) Contacts {
+	c := make(Contacts, len(userIDs))
+	for _, userID := range userIDs {
+		c[userID] = struct{}{}
+	}
+
+	return c
+}
+
+func (c Contacts) Has(userID models.UserID) bool {
+	_, ok := c[userID]
+	return ok
+}
+
+// Все из userIDs, кроме actor, должны быть его контактами.
+func (c Contacts) check(actor models.UserID, userIDs map[models.UserID]struct{}) error {
+	for userID := range userIDs {
+		if userID != actor && !c.Has(userID) {
+			return deny("%s is not a contact of %s", userID, actor)
+		}
+	}
+
+	return nil
+}
+
+// Создавать счёт можно только с собой в участниках: нельзя повесить долги
+// на других, не участвуя в счёте самому. Остальные участники - контакты
+// actor, чтобы нельзя было записать долг на любого пользователя.
+func (p *Policy) CanCreateBill(actor models.UserID, bill models.Bill, contacts Contacts) error {
+	if !bill.HasParticipant(actor) {
+		return deny("%s is not a participant of the bill", actor)
+	}
+
+	return contacts.check(actor, bill.Participants())
+}
+
+// Читать счёт могут владелец и участники.
+func (p *Policy) CanReadBill(actor models.UserID, bill models.Bill) error {
+	if actor == bill.OwnerID || bill.HasParticipant(actor) {
+		return nil
+	}
+
+	return deny("%s can't read %s", actor, bill.ID)
+}
+
+// Владелец удаляет счёт всегда, участники - только в течение deleteGracePeriod
+// после создания.
+func (p *Policy) CanDeleteBill(actor models.UserID, bill models.Bill) error {
+	if actor == bill.OwnerID {
+		return nil
+	}
+
+	if !bill.HasParticipant(actor) {
+		return deny("%s can't delete %s", actor, bill.ID)
+	}
+
+	if p.now().Sub(bill.CreatedAt) > p.deleteGracePeriod {
+		return deny("grace period for deleting %s by participants is over", bill.ID)
+	}
+
+	return nil
+}
+
+// Редактировать счёт могут владелец и участники. Участник не может убрать
+// себя из счёта, оставив долги на других. Новые участники, как и при
+// создании, - только контакты actor.
+func (p *Policy) CanUpdateBill(actor models.UserID, old, updated models.Bill, contacts Contacts) error {
+	if actor != old.OwnerID && !old.HasParticipant(actor) {
+		return deny("%s can't update %s", actor, old.ID)
+	}
+
+	if actor != old.OwnerID && !updated.HasParticipant(actor) {
+		return deny("%s is not a participant of the updated bill", actor)
+	}
+
+	added := updated.Participants()
+	for userID := range old.Participants() {
+		delete(added, userID)
+	}
+
+	return contacts.check(actor, added)
+}
+
+// План взаиморасчётов раскрывает долги всех участников между собой,
+// поэтому запросить его можно только для компании, в которую входишь сам,
+// и только из своих контактов.
+func (p *Policy) CanViewSettlementPlan(actor models.UserID, userIDs []models.UserID, contacts Contacts) error {
+	set := make(map[models.UserID]struct{}, len(userIDs))
+	for _, userID := range userIDs {
+		set[userID] = struct{}{}
+	}
+
+	if _, ok := set[actor]; !ok {
+		return deny("%s is not in the settlement group", actor)
+	}
+
+	return contacts.check(actor, set)
+}
+
+// Погашение записывает одна из сторон.
+func (p *Policy) CanRecordSettlement(actor models.UserID, settlement models.Settlement) error {
+	if actor != settlement.PayerID && actor != settlement.PayeeID {
+		return deny("%s is neither payer nor payee", actor)
+	}
+
+	return nil
+}
+
+// Группу и её балансы видят только участники.
+func (p *Policy) CanViewGroup(actor models.UserID, group models.Group) error {
+	if !group.HasMember(actor) {
+		return deny("%s is not a member of %s", actor, group.ID)
+	}
+
+	return nil
+}
+
+// Состав группы меняют владелец и администраторы. Назначить владельца может
+// только владелец. Любой участник может выйти из группы сам. Пустая роль
+// у member - удаление из группы.
+func (p *Policy) CanManageGroupMember(actor models.UserID, group models.Group, member models.GroupMember) error {
+	self, ok := group.Member(actor)
+	if !ok {
+		return deny("%s is not a member of %s", actor, group.ID)
+	}
+
+	if actor == member.UserID && member.Role == "" {
+		return nil
+	}
+
+	if !self.Role.CanManage() {
+		return deny("%s can't manage members of %s", actor, group.ID)
+	}
+
+	old, _ := group.Member(member.UserID)
+	if self.Role != models.GroupRoleOwner && (member.Role == models.GroupRoleOwner || old.Role == models.GroupRoleOwner) {
+		return deny("only owner can manage owners of %s", group.ID)
+	}
+
+	return nil
+}
+
+// Счёт группы может создать только её участник, и сам он должен участвовать
+// в счёте. Остальные участники счёта - участники группы, это проверяет
+// Group.CheckBillMembers.
+func (p *Policy) CanCreateGroupBill(actor models.UserID, group models.Group, bill models.Bill) error {
+	if err := p.CanViewGroup(actor, group); err != nil {
+		return err
+	}
+
+	if !bill.HasParticipant(actor) {
+		return deny("%s is not a participant of the bill", actor)
+	}
+
+	return nil
+}
+
+// Погашение в группе записывает одна из сторон, и обе должны быть в группе.
+func (p *Policy) CanRecordGroupSettlement(actor models.UserID, group models.Group, settlement models.Settlement) error {
+	if err := p.CanViewGroup(actor, group); err != nil {
+		return err
+	}
+
+	return p.CanRecordSettlement(actor, settlement)
+}
+
+// Пригласить в группу могут те, кто управляет её составом. Приглашение на
+// заглушку создаёт тот, кто её завёл, а на заглушку из группы - ещё и те,
+// кто управляет группой.
+func (p *Policy) CanCreateInvite(actor models.UserID, invite models.Invite, group models.Group, placeholder models.User) error {
+	if invite.GroupID != 0 {
+		if err := p.CanManageGroupMember(actor, group, models.GroupMember{UserID: invite.PlaceholderID, Role: models.GroupRoleMember}); err != nil {
+			return err
+		}
+
+		if invite.PlaceholderID == 0 || group.HasMember(invite.PlaceholderID) || actor == placeholder.CreatedBy {
+			return nil
+		}
+
+		return deny("%s can't invite to %s", actor, invite.PlaceholderID)
+	}
+
+	if actor != placeholder.CreatedBy {
+		return deny("%s can't invite to %s", actor, invite.PlaceholderID)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package authz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2023, 3, 20, 12, 0, 0, 0, time.UTC)
+
+func newTestPolicy(gracePeriod time.Duration) *Policy {
+	return &Policy{
+		deleteGracePeriod: gracePeriod,
+		now:               func() time.Time { return now },
+	}
+}
+
+// Владелец 1, позицию делят 2 и 3, платит 3. Пользователь 4 - посторонний.
+func testBill(createdAt time.Time) models.Bill {
+	return models.Bill{
+		ID:        10,
+		OwnerID:   1,
+		CreatedAt: createdAt,
+		Items: []models.BillItem{
+			{
+				Title:  "Пицца",
+				Shares: []models.BillShare{{UserID: 2, Share: 1}, {UserID: 3, Share: 1}},
+			},
+		},
+		Payments: []models.BillPayment{{UserID: 3}},
+	}
+}
+
+func TestCanCreateBill(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	bill := testBill(now)
+
+	require.NoError(p.CanCreateBill(2, bill, NewContacts(3)))
+	require.NoError(p.CanCreateBill(3, bill, NewContacts(1, 2)))
+	require.ErrorIs(p.CanCreateBill(1, bill, NewContacts(2, 3)), ErrPermissionDenied)
+	require.ErrorIs(p.CanCreateBill(4, bill, NewContacts(2, 3)), ErrPermissionDenied)
+
+	// Долг на незнакомого пользователя не записать.
+	require.ErrorIs(p.CanCreateBill(2, bill, NewContacts()), ErrPermissionDenied)
+	require.ErrorIs(p.CanCreateBill(2, bill, NewContacts(4)), ErrPermissionDenied)
+
+	// Счёт только на себя.
+	solo := testBill(now)
+	solo.Items[0].Shares = []models.BillShare{{UserID: 3, Share: 1}}
+	require.NoError(p.CanCreateBill(3, solo, nil))
+}
+
+func TestCanReadBill(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	bill := testBill(now)
+
+	for _, userID := range []models.UserID{1, 2, 3} {
+		require.NoError(p.CanReadBill(userID, bill))
+	}
+	require.ErrorIs(p.CanReadBill(4, bill), ErrPermissionDenied)
+}
+
+func TestCanDeleteBill(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(15 * time.Minute)
+
+	fresh := testBill(now.Add(-time.Minute))
+	require.NoError(p.CanDeleteBill(1, fresh))
+	require.NoError(p.CanDeleteBill(2, fresh))
+	require.ErrorIs(p.CanDeleteBill(4, fresh), ErrPermissionDenied)
+
+	old := testBill(now.Add(-time.Hour))
+	require.NoError(p.CanDeleteBill(1, old))
+	require.ErrorIs(p.CanDeleteBill(2, old), ErrPermissionDenied)
+
+	ownerOnly := newTestPolicy(0)
+	require.ErrorIs(ownerOnly.CanDeleteBill(2, fresh), ErrPermissionDenied)
+}
+
+func TestCanUpdateBill(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	bill := testBill(now)
+
+	require.NoError(p.CanUpdateBill(1, bill, bill, nil))
+	require.NoError(p.CanUpdateBill(2, bill, bill, nil))
+	require.ErrorIs(p.CanUpdateBill(4, bill, bill, nil), ErrPermissionDenied)
+
+	// 2 убирает себя из позиции.
+	updated := testBill(now)
+	updated.Items[0].Shares = []models.BillShare{{UserID: 3, Share: 1}}
+	require.ErrorIs(p.CanUpdateBill(2, bill, updated, nil), ErrPermissionDenied)
+	require.NoError(p.CanUpdateBill(1, bill, updated, nil))
+
+	// Новый участник - только из контактов.
+	added := testBill(now)
+	added.Items[0].Shares = append(added.Items[0].Shares, models.BillShare{UserID: 5, Share: 1})
+	require.ErrorIs(p.CanUpdateBill(2, bill, added, NewContacts(3)), ErrPermissionDenied)
+	require.NoError(p.CanUpdateBill(2, bill, added, NewContacts(3, 5)))
+}
+
+func TestCanViewSettlementPlan(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+
+	require.NoError(p.CanViewSettlementPlan(2, []models.UserID{1, 2, 3}, NewContacts(1, 3)))
+	require.ErrorIs(p.CanViewSettlementPlan(4, []models.UserID{1, 2, 3}, NewContacts(1, 2, 3)), ErrPermissionDenied)
+
+	// Посторонние в плане раскрыли бы чужие долги.
+	require.ErrorIs(p.CanViewSettlementPlan(2, []models.UserID{1, 2, 3}, NewContacts(1)), ErrPermissionDenied)
+}
+
+func TestCanRecordSettlement(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	settlement := models.Settlement{PayerID: 1, PayeeID: 2}
+
+	require.NoError(p.CanRecordSettlement(1, settlement))
+	require.NoError(p.CanRecordSettlement(2, settlement))
+	require.ErrorIs(p.CanRecordSettlement(3, settlement), ErrPermissionDenied)
+}
+
+// Владелец 1, администратор 2, участник 3. Пользователь 4 - посторонний.
+func testGroup() models.Group {
+	return models.Group{
+		ID: 7,
+		Members: []models.GroupMember{
+			{UserID: 1, Role: models.GroupRoleOwner},
+			{UserID: 2, Role: models.GroupRoleAdmin},
+			{UserID: 3, Role: models.GroupRoleMember},
+		},
+	}
+}
+
+func TestCanViewGroup(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	group := testGroup()
+
+	require.NoError(p.CanViewGroup(3, group))
+	require.ErrorIs(p.CanViewGroup(4, group), ErrPermissionDenied)
+}
+
+func TestCanManageGroupMember(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	group := testGroup()
+
+	newMember := models.GroupMember{UserID: 5, Role: models.GroupRoleMember}
+	require.NoError(p.CanManageGroupMember(1, group, newMember))
+	require.NoError(p.CanManageGroupMember(2, group, newMember))
+	require.ErrorIs(p.CanManageGroupMember(3, group, newMember), ErrPermissionDenied)
+	require.ErrorIs(p.CanManageGroupMember(4, group, newMember), ErrPermissionDenied)
+
+	// Назначать и трогать владельцев может только владелец.
+	require.NoError(p.CanManageGroupMember(1, group, models.GroupMember{UserID: 3, Role: models.GroupRoleOwner}))
+	require.ErrorIs(p.CanManageGroupMember(2, group, models.GroupMember{UserID: 3, Role: models.GroupRoleOwner}), ErrPermissionDenied)
+	require.ErrorIs(p.CanManageGroupMember(2, group, models.GroupMember{UserID: 1}), ErrPermissionDenied)
+
+	// Выйти из группы может любой.
+	require.NoError(p.CanManageGroupMember(3, group, models.GroupMember{UserID: 3}))
+}
+
+func TestCanCreateGroupBill(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	group := testGroup()
+	bill := testBill(now)
+
+	require.NoError(p.CanCreateGroupBill(2, group, bill))
+	require.ErrorIs(p.CanCreateGroupBill(1, group, bill), ErrPermissionDenied)
+
+	bill.Payments = []models.BillPayment{{UserID: 4}}
+	require.ErrorIs(p.CanCreateGroupBill(4, group, bill), ErrPermissionDenied)
+}
+
+func TestCanRecordGroupSettlement(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	group := testGroup()
+
+	require.NoError(p.CanRecordGroupSettlement(2, group, models.Settlement{PayerID: 2, PayeeID: 3}))
+	require.ErrorIs(p.CanRecordGroupSettlement(1, group, models.Settlement{PayerID: 2, PayeeID: 3}), ErrPermissionDenied)
+	require.ErrorIs(p.CanRecordGroupSettlement(4, group, models.Settlement{PayerID: 4, PayeeID: 3}), ErrPermissionDenied)
+}
+
+func TestCanCreateInvite(t *testing.T) {
+	require := require.New(t)
+	p := newTestPolicy(0)
+	group := testGroup()
+	group.Members = append(group.Members, models.GroupMember{UserID: 6, Role: models.GroupRoleMember})
+
+	// Заглушку 5 завёл 3, заглушка 6 - в группе.
+	placeholder := models.User{ID: 5, Placeholder: true, CreatedBy: 3}
+	require.NoError(p.CanCreateInvite(3, models.Invite{PlaceholderID: 5}, models.Group{}, placeholder))
+	require.ErrorIs(p.CanCreateInvite(1, models.Invite{PlaceholderID: 5}, models.Group{}, placeholder), ErrPermissionDenied)
+
+	require.NoError(p.CanCreateInvite(2, models.Invite{GroupID: group.ID}, group, models.User{}))
+	require.ErrorIs(p.CanCreateInvite(3, models.Invite{GroupID: group.ID}, group, models.User{}), ErrPermissionDenied)
+
+	inGroup := models.User{ID: 6, Placeholder: true, CreatedBy: 3}
+	require.NoError(p.CanCreateInvite(2, models.Invite{GroupID: group.ID, PlaceholderID: 6}, group, inGroup))
+	require.ErrorIs(p.CanCreateInvite(2, models.Invite{GroupID: group.ID, PlaceholderID: 5}, group, placeholder), ErrPermissionDenied)
+}
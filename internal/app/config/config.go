@@ -1,6 +1,48 @@
 package config
 
+import "time"
+
 type Config struct {
 	IsLocalRun bool
 	DSN        string
+	Auth       Auth
+	Authz      Authz
+	Invites    Invites
+	Internal   Internal
+}
+
+type Auth struct {
+	// Общий секрет для HS256. Пустой - HS256 не принимаем.
+	HMACSecret string
+	// Публичные ключи RS256/EdDSA в PEM (SubjectPublicKeyInfo).
+	PublicKeysPEM []string
+	// Локальный JWKS-файл с публичными ключами.
+	JWKSFile string
+	// Если задан, то claim iss обязан совпадать.
+	Issuer string
+
+	// Для выпуска токенов (CLI). Приватный ключ RS256/EdDSA в PEM,
+	// если не задан - подписываем HS256.
+	SigningKeyFile string
+	SigningKeyID   string
+	TokenTTL       time.Duration
+}
+
+type Authz struct {
+	// Сколько после создания счёт могут удалить участники, а не только владелец.
+	// Ноль - только владелец.
+	DeleteGracePeriod time.Duration
+}
+
+type Invites struct {
+	// Сколько приглашение действует после создания.
+	TTL time.Duration
+	// Начало ссылки-приглашения, к нему дописывается код. Пустое - только код.
+	LinkBase string
+}
+
+type Internal struct {
+	// Адрес внутреннего Connect-сервера. Он не проверяет пользователя,
+	// поэтому по умолчанию слушает только loopback.
+	Addr string
 }
@@ -2,25 +2,41 @@ package models
 
 import (
 	"fmt"
-	"math/big"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
 var (
-	ErrDiscrepancy       = errors.New("total price and total payments must be equal")
-	ErrInternalAssertion = errors.New("internal assertion")
+	ErrDiscrepancy            = errors.New("total price and total payments must be equal")
+	ErrInternalAssertion      = errors.New("internal assertion")
+	ErrBillNotFound           = errors.New("bill not found")
+	ErrQuantityPrecisionRange = errors.New("quantity precision is out of range")
+	ErrRevisionConflict       = errors.New("bill was changed by someone else")
 )
 
 const (
-	maxIntFloat64            = float64(1 << 53)
-	CurrentBillSchemaVersion = 1
+	maxIntFloat64 = float64(1 << 53)
+	// 2 - дробное количество в позициях и QuantityPrecision.
+	CurrentBillSchemaVersion = 2
+
+	// Сколько знаков после запятой в количестве, если в счёте не задано.
+	DefaultQuantityPrecision = 3
+	MaxQuantityPrecision     = 6
 )
 
 type Bill struct {
-	ID       BillID        `json:"-"`
-	Items    []BillItem    `json:",omitempty"`
-	Payments []BillPayment `json:",omitempty"`
+	ID                BillID           `json:"-"`
+	OwnerID           UserID           `json:"-"`
+	CreatedAt         time.Time        `json:"-"`
+	Revision          int              `json:"-"`
+	GroupID           GroupID          `json:"-"`
+	Currency          Currency         `json:",omitempty"`
+	Rounding          RoundingStrategy `json:",omitempty"`
+	QuantityPrecision int32            `json:",omitempty"`
+	Items             []BillItem       `json:",omitempty"`
+	Adjustments       []BillAdjustment `json:",omitempty"`
+	Payments          []BillPayment    `json:",omitempty"`
 }
 
 type BillID int64
@@ -29,10 +45,53 @@ func (bid BillID) String() string {
 	return fmt.Sprintf("BillID(%d)", bid)
 }
 
+// Фильтр для постраничного списка счетов. Нулевые значения - без ограничений.
+type BillListFilter struct {
+	ParticipantID UserID
+	GroupID       GroupID
+	// Полуинтервал [CreatedFrom, CreatedTo)
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Курсор: только счета с ID меньше, чем этот.
+	BeforeID BillID
+	// Обход от старых к новым, для выгрузки: счета по возрастанию ID,
+	// курсор - AfterID.
+	OldestFirst bool
+	AfterID     BillID
+	Limit       uint64
+}
+
 func (b *Bill) GetSchemaVersion() int {
 	return CurrentBillSchemaVersion
 }
 
+// Все, кто есть в долях позиций, надбавках или в оплатах.
+func (b *Bill) Participants() map[UserID]struct{} {
+	res := map[UserID]struct{}{}
+	for _, item := range b.Items {
+		for _, share := range item.Shares {
+			res[share.UserID] = struct{}{}
+		}
+	}
+
+	for _, adjustment := range b.Adjustments {
+		for _, userID := range adjustment.UserIDs {
+			res[userID] = struct{}{}
+		}
+	}
+
+	for _, payment := range b.Payments {
+		res[payment.UserID] = struct{}{}
+	}
+
+	return res
+}
+
+func (b *Bill) HasParticipant(userID UserID) bool {
+	_, ok := b.Participants()[userID]
+	return ok
+}
+
 func (b *Bill) TotalPayment() Money {
 	totalPayment := NewMoney()
 	for _, payment := range b.Payments {
@@ -42,35 +101,97 @@ func (b *Bill) TotalPayment() Money {
 	return totalPayment
 }
 
-func (b *Bill) TotalPrice() Money {
+// Сумма позиций без надбавок и скидок.
+func (b *Bill) ItemsTotalPrice() Money {
 	totalPrice := NewMoney()
 	for _, item := range b.Items {
-		totalPrice.Decimal = totalPrice.Add(item.TotalPrice().Decimal)
+		totalPrice.Decimal = totalPrice.Add(item.TotalPrice(b.GetCurrency()).Decimal)
+	}
+
+	return totalPrice
+}
+
+// Сумма позиций с надбавками и скидками.
+func (b *Bill) TotalPrice() Money {
+	itemsTotal := b.ItemsTotalPrice()
+	totalPrice := itemsTotal
+	for _, adjustment := range b.Adjustments {
+		totalPrice.Decimal = totalPrice.Add(adjustment.Value(itemsTotal, b.GetCurrency()).Decimal)
 	}
 
 	return totalPrice
 }
 
+// Валюта счёта. У счетов без валюты - DefaultCurrency.
+func (b *Bill) GetCurrency() Currency {
+	return b.Currency.OrDefault()
+}
+
+// Точность количества в позициях. У счетов без неё - DefaultQuantityPrecision.
+func (b *Bill) GetQuantityPrecision() int32 {
+	if b.QuantityPrecision == 0 {
+		return DefaultQuantityPrecision
+	}
+
+	return b.QuantityPrecision
+}
+
 func (b *Bill) Validate() error {
+	currency := b.GetCurrency()
+	if err := currency.Validate(); err != nil {
+		return err
+	}
+
+	if err := b.Rounding.Validate(); err != nil {
+		return err
+	}
+
+	quantityPrecision := b.GetQuantityPrecision()
+	if quantityPrecision < 1 || quantityPrecision > MaxQuantityPrecision {
+		return errors.Wrapf(ErrQuantityPrecisionRange, "%d not in [1, %d]", quantityPrecision, MaxQuantityPrecision)
+	}
+
 	for index, item := range b.Items {
-		if err := item.Validate(); err != nil {
+		if err := item.Validate(currency, quantityPrecision); err != nil {
 			return errors.Wrapf(err, "item at index %d is invalid", index)
 		}
 	}
 
+	for index, adjustment := range b.Adjustments {
+		if err := adjustment.Validate(currency); err != nil {
+			return errors.Wrapf(err, "adjustment at index %d is invalid", index)
+		}
+
+		if adjustment.GetSplit() == AdjustmentSplitProportional && len(b.Items) == 0 {
+			return errors.Wrapf(ErrNoItemsToProportion, "adjustment at index %d", index)
+		}
+	}
+
 	for index, p := range b.Payments {
-		if err := p.Validate(); err != nil {
+		if err := p.Validate(currency); err != nil {
 			return errors.Wrapf(err, "payment at index %d is invalid", index)
 		}
 	}
 
 	totalPrice := b.TotalPrice()
-	if err := totalPrice.Validate(); err != nil {
+	if err := totalPrice.Validate(currency); err != nil {
 		return errors.Wrapf(err, "TotalPrice has error")
 	}
 
+	// Скидка не может сделать чей-то долг отрицательным.
+	debits, err := b.debitByUser()
+	if err != nil {
+		return err
+	}
+
+	for userID, debit := range debits {
+		if debit.Sign() < 0 {
+			return errors.Wrapf(ErrNegativeDebit, "%s", userID)
+		}
+	}
+
 	totalPayment := b.TotalPayment()
-	if err := totalPayment.Validate(); err != nil {
+	if err := totalPayment.Validate(currency); err != nil {
 		return errors.Wrapf(err, "TotalPayment has error")
 	}
 
@@ -81,11 +202,44 @@ func (b *Bill) Validate() error {
 	return nil
 }
 
+// Сколько каждый должен за позиции и надбавки. Счёт должен быть валидным.
 func (b *Bill) DebitByUser() map[UserID]MoneyRat {
+	res, _ := b.debitByUser()
+	return res
+}
+
+func (b *Bill) debitByUser() (map[UserID]MoneyRat, error) {
+	res := b.itemDebitByUser()
+	if len(b.Adjustments) == 0 {
+		return res, nil
+	}
+
+	// Отдельная копия: суммы в res меняются на месте.
+	itemDebits := b.itemDebitByUser()
+	itemsTotal := b.ItemsTotalPrice()
+	for index, adjustment := range b.Adjustments {
+		shares, err := adjustment.SharesByUser(adjustment.Value(itemsTotal, b.GetCurrency()), itemDebits)
+		if err != nil {
+			return nil, errors.Wrapf(err, "adjustment at index %d", index)
+		}
+
+		for userID, share := range shares {
+			old, ok := res[userID]
+			if !ok {
+				old = NewMoneyRat()
+			}
+			res[userID] = MoneyRat{old.Add(old.Rat, share.Rat)}
+		}
+	}
+
+	return res, nil
+}
+
+func (b *Bill) itemDebitByUser() map[UserID]MoneyRat {
 	res := map[UserID]MoneyRat{}
 
 	for _, item := range b.Items {
-		for userId, moneyRat := range item.SharePricesByUser() {
+		for userId, moneyRat := range item.SharePricesByUser(b.GetCurrency()) {
 			old := NewMoneyRat()
 			if v, ok := res[userId]; ok {
 				old = v
@@ -143,36 +297,153 @@ func (b *Bill) ToInvoices() ([]Invoice, error) {
 		return nil, err
 	}
 
-	zeroRat := big.NewRat(0, 1)
-	balancesMap := b.BalanceByUser()
-
-	invoices, err := InvoicesFromBalances(balancesMap)
+	currency := b.GetCurrency()
+	exact, err := ratInvoicesFromBalances(b.BalanceByUser(), currency)
 	if err != nil {
 		return nil, errors.Wrap(err, "fail construct Invoices")
 	}
 
 	// Исправление неточности конечной суммы при переходе от Rational к Decimal.
 	// Напримео, 100 на троих это 33.33, 33.33 и 33.33, итого не достаёт копейки 0.01.
-	// Исправленное: 33.33, 33.33 и 33.34 (33.33 + 0.01).
+	// Кому достанется копейка, решает стратегия округления счёта.
+	invoices, err := b.Rounding.round(exact, currency, b.OwnerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "error at fix total")
+	}
+
+	return invoices, nil
+}
+
+const DefaultBillUpgradeBatchSize = 500
+
+// Параметры переписывания счетов на CurrentBillSchemaVersion.
+type BillUpgradeOptions struct {
+	BatchSize uint64
+	// Только проверить: прочитать, привести и провалидировать, но не сохранять.
+	DryRun bool
+}
+
+func (o BillUpgradeOptions) GetBatchSize() uint64 {
+	if o.BatchSize == 0 {
+		return DefaultBillUpgradeBatchSize
+	}
+
+	return o.BatchSize
+}
+
+type BillUpgradeReport struct {
+	Checked  int
+	Upgraded int
+	// Счета, которые не читаются или невалидны после апгрейда. Остаются как были.
+	Failed []BillUpgradeFailure
+}
+
+type BillUpgradeFailure struct {
+	BillID        BillID
+	SchemaVersion int
+	Err           error
+}
+
+// Счёт, в котором пользователь from заменён на to. Доли одной позиции и
+// оплаты, которые после замены оказались у to дважды, складываются.
+func (b Bill) WithUserReplaced(from, to UserID) Bill {
+	res := b
+
+	res.Items = make([]BillItem, 0, len(b.Items))
+	for _, item := range b.Items {
+		shares := make([]BillShare, 0, len(item.Shares))
+		for _, share := range item.Shares {
+			if share.UserID == from {
+				share.UserID = to
+			}
+			shares = mergeShare(shares, share)
+		}
 
-	// Считаем сумму выданных денег. Т.к. баланс нулевой, то это равно и сумму одолженых.
-	totalCredit := NewMoneyRat()
-	for _, v := range balancesMap {
-		if v.Rat.Cmp(zeroRat) == 1 {
-			totalCredit.Add(totalCredit.Rat, v.Rat)
+		item.Shares = shares
+		res.Items = append(res.Items, item)
+	}
+
+	res.Adjustments = make([]BillAdjustment, 0, len(b.Adjustments))
+	for _, adjustment := range b.Adjustments {
+		if len(adjustment.UserIDs) != 0 {
+			userIDs := make([]UserID, 0, len(adjustment.UserIDs))
+			seen := map[UserID]struct{}{}
+			for _, userID := range adjustment.UserIDs {
+				if userID == from {
+					userID = to
+				}
+
+				if _, ok := seen[userID]; !ok {
+					seen[userID] = struct{}{}
+					userIDs = append(userIDs, userID)
+				}
+			}
+			adjustment.UserIDs = userIDs
 		}
+
+		res.Adjustments = append(res.Adjustments, adjustment)
 	}
 
-	// в округлении накидываем в сторону должников
-	totalCreditDecimal := NewMoneyFromBig(totalCredit.Num()).
-		Div(NewMoneyFromBig(totalCredit.Denom()).Decimal).
-		RoundCeil(MoneyPrecision).
-		Truncate(MoneyPrecision)
+	res.Payments = make([]BillPayment, 0, len(b.Payments))
+	for _, payment := range b.Payments {
+		if payment.UserID == from {
+			payment.UserID = to
+		}
 
-	invoices, err = FixInvocesTotal(invoices, Money{totalCreditDecimal})
-	if err != nil {
-		return nil, errors.Wrap(err, "error at fix total")
+		merged := false
+		for i := range res.Payments {
+			if res.Payments[i].UserID == payment.UserID {
+				res.Payments[i].Amount = Money{res.Payments[i].Amount.Add(payment.Amount.Decimal)}
+				merged = true
+				break
+			}
+		}
+
+		if !merged {
+			res.Payments = append(res.Payments, payment)
+		}
 	}
 
-	return invoices, nil
+	if len(b.Items) == 0 {
+		res.Items = nil
+	}
+	if len(b.Adjustments) == 0 {
+		res.Adjustments = nil
+	}
+	if len(b.Payments) == 0 {
+		res.Payments = nil
+	}
+
+	return res
+}
+
+// Добавляет долю к shares, складывая её с уже имеющейся долей того же
+// пользователя в том же режиме. Доли остатка не складываются: остаток
+// делится поровну между долями, и две доли - это две части остатка.
+func mergeShare(shares []BillShare, share BillShare) []BillShare {
+	for i := range shares {
+		s := &shares[i]
+		if s.UserID != share.UserID || s.GetMode() != share.GetMode() || s.GetMode() == ShareModeRemainder {
+			continue
+		}
+
+		switch s.GetMode() {
+		case ShareModeWeight:
+			s.Share += share.Share
+		case ShareModeAmount:
+			if s.Amount != nil && share.Amount != nil {
+				amount := Money{s.Amount.Add(share.Amount.Decimal)}
+				s.Amount = &amount
+			}
+		case ShareModePercent:
+			if s.Percent != nil && share.Percent != nil {
+				percent := s.Percent.Add(*share.Percent)
+				s.Percent = &percent
+			}
+		}
+
+		return shares
+	}
+
+	return append(shares, share)
 }
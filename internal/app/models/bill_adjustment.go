@@ -0,0 +1,143 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidAdjustment       = errors.New("invalid adjustment")
+	ErrNegativeDebit           = errors.New("user's debit is negative")
+	ErrNoItemsToProportion     = errors.New("adjustment can't be proportional to empty items")
+	ErrUnknownAdjustmentKind   = errors.New("unknown adjustment kind")
+	ErrUnknownAdjustmentSplit  = errors.New("unknown adjustment split")
+	ErrAdjustmentValueNotOneOf = errors.New("exactly one of percent or amount must be set")
+)
+
+// Вид надбавки к счёту. Скидка уменьшает счёт, остальные - увеличивают.
+type AdjustmentKind string
+
+const (
+	AdjustmentTip           AdjustmentKind = "tip"
+	AdjustmentTax           AdjustmentKind = "tax"
+	AdjustmentServiceCharge AdjustmentKind = "service_charge"
+	AdjustmentDiscount      AdjustmentKind = "discount"
+)
+
+// Как надбавка делится между пользователями.
+type AdjustmentSplit string
+
+const (
+	// Пропорционально тому, сколько каждый должен за позиции. По умолчанию.
+	AdjustmentSplitProportional AdjustmentSplit = "proportional"
+	// Поровну между UserIDs.
+	AdjustmentSplitEqual AdjustmentSplit = "equal"
+)
+
+// Надбавка или скидка на весь счёт: процент от суммы позиций или
+// фиксированная сумма. Процент всегда считается от суммы позиций, а не
+// от суммы с другими надбавками, и округляется до минимальной единицы валюты.
+type BillAdjustment struct {
+	Title   string
+	Kind    AdjustmentKind
+	Percent decimal.Decimal
+	Amount  Money
+	Split   AdjustmentSplit `json:",omitempty"`
+	UserIDs []UserID        `json:",omitempty"`
+}
+
+func (a *BillAdjustment) GetSplit() AdjustmentSplit {
+	if a.Split == "" {
+		return AdjustmentSplitProportional
+	}
+
+	return a.Split
+}
+
+func (a *BillAdjustment) Validate(currency Currency) error {
+	switch a.Kind {
+	case AdjustmentTip, AdjustmentTax, AdjustmentServiceCharge, AdjustmentDiscount:
+	default:
+		return errors.Wrapf(ErrUnknownAdjustmentKind, "%q", string(a.Kind))
+	}
+
+	if a.Percent.IsZero() == a.Amount.IsZero() {
+		return ErrAdjustmentValueNotOneOf
+	}
+
+	if a.Percent.IsNegative() || a.Amount.IsNegative() {
+		return errors.Wrap(ErrInvalidAdjustment, "value must be positive, use discount to decrease the bill")
+	}
+
+	if err := a.Amount.Validate(currency); err != nil {
+		return errors.Wrap(err, "Amount has error")
+	}
+
+	switch a.GetSplit() {
+	case AdjustmentSplitProportional:
+		if len(a.UserIDs) != 0 {
+			return errors.Wrap(ErrInvalidAdjustment, "proportional adjustment is split among all users")
+		}
+	case AdjustmentSplitEqual:
+		if len(a.UserIDs) == 0 {
+			return errors.Wrap(ErrInvalidAdjustment, "no users to split equally")
+		}
+
+		seen := map[UserID]struct{}{}
+		for _, userID := range a.UserIDs {
+			if _, ok := seen[userID]; ok {
+				return errors.Wrapf(ErrInvalidAdjustment, "duplicate %s", userID)
+			}
+			seen[userID] = struct{}{}
+		}
+	default:
+		return errors.Wrapf(ErrUnknownAdjustmentSplit, "%q", string(a.Split))
+	}
+
+	return nil
+}
+
+// Сумма надбавки со знаком: скидка отрицательная.
+func (a *BillAdjustment) Value(itemsTotal Money, currency Currency) Money {
+	value := a.Amount.Decimal
+	if !a.Percent.IsZero() {
+		value = itemsTotal.Mul(a.Percent).Div(decimal.NewFromInt(100)).Round(currency.Precision())
+	}
+
+	if a.Kind == AdjustmentDiscount {
+		value = value.Neg()
+	}
+
+	return Money{value}
+}
+
+// Доли надбавки по пользователям. itemDebits - кто сколько должен за позиции.
+func (a *BillAdjustment) SharesByUser(value Money, itemDebits map[UserID]MoneyRat) (map[UserID]MoneyRat, error) {
+	res := map[UserID]MoneyRat{}
+
+	switch a.GetSplit() {
+	case AdjustmentSplitEqual:
+		part := new(big.Rat).Quo(value.Rat(), big.NewRat(int64(len(a.UserIDs)), 1))
+		for _, userID := range a.UserIDs {
+			res[userID] = MoneyRat{new(big.Rat).Set(part)}
+		}
+	default:
+		itemsTotal := NewMoneyRat()
+		for _, debit := range itemDebits {
+			itemsTotal.Add(itemsTotal.Rat, debit.Rat)
+		}
+
+		if itemsTotal.Sign() == 0 {
+			return nil, ErrNoItemsToProportion
+		}
+
+		for userID, debit := range itemDebits {
+			share := new(big.Rat).Mul(value.Rat(), debit.Rat)
+			res[userID] = MoneyRat{share.Quo(share, itemsTotal.Rat)}
+		}
+	}
+
+	return res, nil
+}
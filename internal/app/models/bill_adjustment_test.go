@@ -0,0 +1,84 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// Алиса (1) заказала на 300, Боб (2) - на 100, платит Алиса.
+func dinner(adjustments ...models.BillAdjustment) models.Bill {
+	return models.Bill{
+		Items: []models.BillItem{
+			{Title: "steak", PricePerOne: money("300"), Quantity: decimal.NewFromInt(1), Shares: []models.BillShare{{UserID: 1, Share: 1}}},
+			{Title: "salad", PricePerOne: money("100"), Quantity: decimal.NewFromInt(1), Shares: []models.BillShare{{UserID: 2, Share: 1}}},
+		},
+		Adjustments: adjustments,
+	}
+}
+
+func requireDebit(t *testing.T, bill models.Bill, userID models.UserID, want string) {
+	debit := bill.DebitByUser()[userID]
+	require.Equal(t, 0, debit.Cmp(decimal.RequireFromString(want).Rat()), "%s: got %s", userID, debit.FloatString(4))
+}
+
+func TestBillAdjustments(t *testing.T) {
+	require := require.New(t)
+
+	// 10% обслуживания пропорционально, 20 чаевых поровну, скидка 40 поровну.
+	bill := dinner(
+		models.BillAdjustment{Kind: models.AdjustmentServiceCharge, Percent: decimal.NewFromInt(10)},
+		models.BillAdjustment{Kind: models.AdjustmentTip, Amount: money("20"), Split: models.AdjustmentSplitEqual, UserIDs: []models.UserID{1, 2}},
+		models.BillAdjustment{Kind: models.AdjustmentDiscount, Amount: money("40"), Split: models.AdjustmentSplitEqual, UserIDs: []models.UserID{1, 2}},
+	)
+	bill.Payments = []models.BillPayment{{UserID: 1, Amount: money("420")}}
+
+	require.True(bill.TotalPrice().Equal(decimal.NewFromInt(420)), "got %s", bill.TotalPrice())
+	require.NoError(bill.Validate())
+
+	requireDebit(t, bill, 1, "320") // 300 + 30 + 10 - 20
+	requireDebit(t, bill, 2, "100") // 100 + 10 + 10 - 20
+
+	invoices, err := bill.ToInvoices()
+	require.NoError(err)
+	require.Len(invoices, 1)
+	require.True(invoices[0].Value.Equal(decimal.NewFromInt(100)))
+}
+
+func TestBillAdjustmentsPercentRounding(t *testing.T) {
+	require := require.New(t)
+
+	// 12.5% от 400 = 50, от 0.33 - 0.04125 -> 0.04.
+	bill := dinner(models.BillAdjustment{Kind: models.AdjustmentTax, Percent: decimal.RequireFromString("12.5")})
+	require.True(bill.TotalPrice().Equal(decimal.NewFromInt(450)))
+
+	bill.Items = []models.BillItem{{Title: "gum", PricePerOne: money("0.33"), Quantity: decimal.NewFromInt(1), Shares: []models.BillShare{{UserID: 1, Share: 1}}}}
+	require.True(bill.TotalPrice().Equal(decimal.RequireFromString("0.37")), "got %s", bill.TotalPrice())
+}
+
+func TestBillAdjustmentsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		adjustment models.BillAdjustment
+		isErr      error
+	}{
+		{name: "unknown kind", adjustment: models.BillAdjustment{Kind: "bribe", Amount: money("1")}, isErr: models.ErrUnknownAdjustmentKind},
+		{name: "no value", adjustment: models.BillAdjustment{Kind: models.AdjustmentTip}, isErr: models.ErrAdjustmentValueNotOneOf},
+		{name: "both values", adjustment: models.BillAdjustment{Kind: models.AdjustmentTip, Amount: money("1"), Percent: decimal.NewFromInt(1)}, isErr: models.ErrAdjustmentValueNotOneOf},
+		{name: "negative", adjustment: models.BillAdjustment{Kind: models.AdjustmentTip, Amount: money("-1")}, isErr: models.ErrInvalidAdjustment},
+		{name: "equal without users", adjustment: models.BillAdjustment{Kind: models.AdjustmentTip, Amount: money("1"), Split: models.AdjustmentSplitEqual}, isErr: models.ErrInvalidAdjustment},
+		{name: "unknown split", adjustment: models.BillAdjustment{Kind: models.AdjustmentTip, Amount: money("1"), Split: "random"}, isErr: models.ErrUnknownAdjustmentSplit},
+		{name: "precision", adjustment: models.BillAdjustment{Kind: models.AdjustmentTip, Amount: money("0.001")}, isErr: models.ErrMoneyPrecision},
+		// Скидка 300 поровну: у Боба 100 - 150 < 0.
+		{name: "negative debit", adjustment: models.BillAdjustment{Kind: models.AdjustmentDiscount, Amount: money("300"), Split: models.AdjustmentSplitEqual, UserIDs: []models.UserID{1, 2}}, isErr: models.ErrNegativeDebit},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bill := dinner(tc.adjustment)
+			bill.Payments = []models.BillPayment{{UserID: 1, Amount: bill.TotalPrice()}}
+
+			require.ErrorIs(t, bill.Validate(), tc.isErr)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Сохранённая версия счёта: кто и когда её сохранил.
+type BillRevision struct {
+	Revision  int
+	EditorID  UserID
+	CreatedAt time.Time
+	Bill      Bill
+}
+
+// Версия счёта и её отличия от предыдущей. У первой версии - от пустого счёта.
+type BillHistoryEntry struct {
+	BillRevision
+	Diff BillDiff
+}
+
+// Отличия двух версий счёта. Позиции сопоставляются по названию, позиции с
+// одинаковым названием - по порядку.
+type BillDiff struct {
+	ItemsAdded   []BillItem
+	ItemsRemoved []BillItem
+	ItemsChanged []BillItemChange
+	// Только пользователи, у которых баланс по счёту изменился. При смене
+	// валюты - все, у кого был или стал ненулевой баланс.
+	BalanceChanges []BalanceChange
+}
+
+func (d *BillDiff) IsEmpty() bool {
+	return len(d.ItemsAdded) == 0 && len(d.ItemsRemoved) == 0 &&
+		len(d.ItemsChanged) == 0 && len(d.BalanceChanges) == 0
+}
+
+type BillItemChange struct {
+	Title string
+	Old   BillItem
+	New   BillItem
+	// Только доли, которые изменились, в порядке UserID.
+	ShareChanges []ShareChange
+}
+
+func (c *BillItemChange) PriceChanged() bool {
+	return !c.Old.PricePerOne.Equal(c.New.PricePerOne.Decimal)
+}
+
+func (c *BillItemChange) QuantityChanged() bool {
+	return !c.Old.Quantity.Equal(c.New.Quantity)
+}
+
+// Доля пользователя до и после. nil - доли не было.
+type ShareChange struct {
+	UserID UserID
+	Old    *BillShare
+	New    *BillShare
+}
+
+// Баланс пользователя по счёту, как в Bill.BalanceByUser: положительный -
+// ему должны, отрицательный - должен он. Old в валюте старой версии, New -
+// в валюте новой.
+type BalanceChange struct {
+	UserID      UserID
+	Old         Money
+	OldCurrency Currency
+	New         Money
+	Currency    Currency
+}
+
+func (c *BalanceChange) CurrencyChanged() bool {
+	return c.OldCurrency != c.Currency
+}
+
+// Имеет смысл только без смены валюты.
+func (c *BalanceChange) Delta() Money {
+	return Money{c.New.Sub(c.Old.Decimal)}
+}
+
+func DiffBills(old, updated Bill) BillDiff {
+	diff := BillDiff{}
+
+	oldItems := itemsByKey(old.Items)
+	matched := map[string]struct{}{}
+	for _, key := range itemKeys(updated.Items) {
+		newItem := updated.Items[key.index]
+		oldItem, ok := oldItems[key.key]
+		if !ok {
+			diff.ItemsAdded = append(diff.ItemsAdded, newItem)
+			continue
+		}
+
+		matched[key.key] = struct{}{}
+		change := BillItemChange{
+			Title:        newItem.Title,
+			Old:          oldItem,
+			New:          newItem,
+			ShareChanges: diffShares(oldItem.Shares, newItem.Shares),
+		}
+		if change.PriceChanged() || change.QuantityChanged() || len(change.ShareChanges) != 0 {
+			diff.ItemsChanged = append(diff.ItemsChanged, change)
+		}
+	}
+
+	for _, key := range itemKeys(old.Items) {
+		if _, ok := matched[key.key]; !ok {
+			diff.ItemsRemoved = append(diff.ItemsRemoved, old.Items[key.index])
+		}
+	}
+
+	diff.BalanceChanges = diffBalances(old, updated)
+
+	return diff
+}
+
+type itemKey struct {
+	key   string
+	index int
+}
+
+// Ключ позиции - название и номер среди позиций с таким же названием.
+func itemKeys(items []BillItem) []itemKey {
+	seen := map[string]int{}
+	res := make([]itemKey, 0, len(items))
+	for i, item := range items {
+		res = append(res, itemKey{key: fmt.Sprintf("%s#%d", item.Title, seen[item.Title]), index: i})
+		seen[item.Title]++
+	}
+
+	return res
+}
+
+func itemsByKey(items []BillItem) map[string]BillItem {
+	res := make(map[string]BillItem, len(items))
+	for _, key := range itemKeys(items) {
+		res[key.key] = items[key.index]
+	}
+
+	return res
+}
+
+func diffShares(old, updated []BillShare) []ShareChange {
+	oldByUser := sharesByUser(old)
+	newByUser := sharesByUser(updated)
+
+	userIDs := make([]UserID, 0, len(oldByUser)+len(newByUser))
+	for userID := range oldByUser {
+		userIDs = append(userIDs, userID)
+	}
+	for userID := range newByUser {
+		if _, ok := oldByUser[userID]; !ok {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	res := []ShareChange{}
+	for _, userID := range userIDs {
+		o, n := oldByUser[userID], newByUser[userID]
+		if o != nil && n != nil && o.equal(n) {
+			continue
+		}
+
+		res = append(res, ShareChange{UserID: userID, Old: o, New: n})
+	}
+
+	return res
+}
+
+func (bs *BillShare) equal(other *BillShare) bool {
+	var amount, otherAmount *decimal.Decimal
+	if bs.Amount != nil {
+		amount = &bs.Amount.Decimal
+	}
+	if other.Amount != nil {
+		otherAmount = &other.Amount.Decimal
+	}
+
+	return bs.UserID == other.UserID && bs.Share == other.Share && bs.GetMode() == other.GetMode() &&
+		decimalPtrEqual(amount, otherAmount) && decimalPtrEqual(bs.Percent, other.Percent)
+}
+
+func decimalPtrEqual(a, b *decimal.Decimal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// Если у пользователя несколько долей в позиции, берём последнюю.
+func sharesByUser(shares []BillShare) map[UserID]*BillShare {
+	res := make(map[UserID]*BillShare, len(shares))
+	for i := range shares {
+		res[shares[i].UserID] = &shares[i]
+	}
+
+	return res
+}
+
+func diffBalances(old, updated Bill) []BalanceChange {
+	oldCurrency, currency := old.GetCurrency(), updated.GetCurrency()
+	oldBalances := old.BalanceByUser()
+	newBalances := updated.BalanceByUser()
+
+	userIDs := make([]UserID, 0, len(oldBalances)+len(newBalances))
+	for userID := range oldBalances {
+		userIDs = append(userIDs, userID)
+	}
+	for userID := range newBalances {
+		if _, ok := oldBalances[userID]; !ok {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	res := []BalanceChange{}
+	for _, userID := range userIDs {
+		change := BalanceChange{
+			UserID:      userID,
+			Old:         NewMoney(),
+			OldCurrency: oldCurrency,
+			New:         NewMoney(),
+			Currency:    currency,
+		}
+		if v, ok := oldBalances[userID]; ok {
+			change.Old = v.Money(oldCurrency)
+		}
+		if v, ok := newBalances[userID]; ok {
+			change.New = v.Money(currency)
+		}
+
+		// Суммы в разных валютах не сравнимы, поэтому при смене валюты
+		// отличаются все ненулевые балансы.
+		changed := !change.Old.Equal(change.New.Decimal)
+		if change.CurrencyChanged() {
+			changed = !change.Old.IsZero() || !change.New.IsZero()
+		}
+
+		if changed {
+			res = append(res, change)
+		}
+	}
+
+	return res
+}
+
+// История счёта по версиям, отсортированным по Revision.
+func BillHistory(revisions []BillRevision) []BillHistoryEntry {
+	res := make([]BillHistoryEntry, 0, len(revisions))
+
+	prev := Bill{}
+	for _, r := range revisions {
+		res = append(res, BillHistoryEntry{
+			BillRevision: r,
+			Diff:         DiffBills(prev, r.Bill),
+		})
+		prev = r.Bill
+	}
+
+	return res
+}
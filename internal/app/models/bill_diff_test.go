@@ -0,0 +1,97 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffBills(t *testing.T) {
+	require := require.New(t)
+
+	old := dinner()
+	old.Payments = []models.BillPayment{{UserID: 1, Amount: money("400")}}
+
+	// Салат подорожал и его теперь делят пополам, стейк убрали, добавили вино.
+	updated := dinner()
+	updated.Items = []models.BillItem{
+		{Title: "salad", PricePerOne: money("120"), Quantity: decimal.NewFromInt(1), Shares: []models.BillShare{{UserID: 2, Share: 1}, {UserID: 1, Share: 1}}},
+		{Title: "wine", PricePerOne: money("200"), Quantity: decimal.RequireFromString("0.5"), Shares: []models.BillShare{{UserID: 2, Share: 1}}},
+	}
+	updated.Payments = []models.BillPayment{{UserID: 1, Amount: money("220")}}
+
+	diff := models.DiffBills(old, updated)
+	require.Len(diff.ItemsAdded, 1)
+	require.Equal("wine", diff.ItemsAdded[0].Title)
+	require.Len(diff.ItemsRemoved, 1)
+	require.Equal("steak", diff.ItemsRemoved[0].Title)
+
+	require.Len(diff.ItemsChanged, 1)
+	change := diff.ItemsChanged[0]
+	require.Equal("salad", change.Title)
+	require.True(change.PriceChanged())
+	require.False(change.QuantityChanged())
+	require.Len(change.ShareChanges, 1)
+	require.Equal(models.UserID(1), change.ShareChanges[0].UserID)
+	require.Nil(change.ShareChanges[0].Old)
+	require.NotNil(change.ShareChanges[0].New)
+
+	// Было: 1 +100, 2 -100. Стало: 2 должен 60 + 100 = 160.
+	require.Len(diff.BalanceChanges, 2)
+	require.Equal(models.UserID(1), diff.BalanceChanges[0].UserID)
+	require.True(diff.BalanceChanges[0].New.Equal(decimal.NewFromInt(160)))
+	require.True(diff.BalanceChanges[1].Delta().Equal(decimal.NewFromInt(-60)))
+
+	same := models.DiffBills(updated, updated)
+	require.True(same.IsEmpty())
+}
+
+func TestBillHistory(t *testing.T) {
+	require := require.New(t)
+
+	first := dinner()
+	first.Payments = []models.BillPayment{{UserID: 1, Amount: money("400")}}
+
+	history := models.BillHistory([]models.BillRevision{
+		{Revision: 1, EditorID: 1, Bill: first},
+		{Revision: 2, EditorID: 2, Bill: first},
+	})
+	require.Len(history, 2)
+	require.Len(history[0].Diff.ItemsAdded, 2)
+	require.True(history[1].Diff.IsEmpty())
+	require.Equal(models.UserID(2), history[1].EditorID)
+}
+
+func TestDiffBillsCurrencyChange(t *testing.T) {
+	require := require.New(t)
+
+	// Такси на двоих оплатил первый, потом счёт перевели из рублей в иены.
+	old := models.Bill{
+		Items:    []models.BillItem{{Title: "taxi", PricePerOne: money("100.5"), Quantity: decimal.NewFromInt(1), Shares: []models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 1}}}},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("100.5")}},
+	}
+	updated := models.Bill{
+		Items:    []models.BillItem{{Title: "taxi", PricePerOne: money("100"), Quantity: decimal.NewFromInt(1), Shares: []models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 1}}}},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("100")}},
+		Currency: "JPY",
+	}
+
+	diff := models.DiffBills(old, updated)
+	require.Len(diff.BalanceChanges, 2)
+
+	change := diff.BalanceChanges[0]
+	require.True(change.CurrencyChanged())
+	require.Equal(models.DefaultCurrency, change.OldCurrency)
+	require.Equal(models.Currency("JPY"), change.Currency)
+	// Старый баланс округлён в рублях, а не в иенах.
+	require.True(change.Old.Equal(decimal.RequireFromString("50.25")), "got %s", change.Old)
+	require.True(change.New.Equal(decimal.NewFromInt(50)), "got %s", change.New)
+
+	// Та же сумма в другой валюте - тоже изменение.
+	updated.Items[0].PricePerOne = money("100.5")
+	updated.Payments[0].Amount = money("100.5")
+	updated.Currency = "USD"
+	require.Len(models.DiffBills(old, updated).BalanceChanges, 2)
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidBillImport = errors.New("invalid bill import")
+)
+
+// Счёт из строки внешнего файла. SourceHash - отпечаток строки: по нему
+// повторная загрузка того же файла не создаёт счёт второй раз.
+type ImportedBill struct {
+	Line       int
+	SourceHash string
+	Bill       Bill
+}
+
+func (ib *ImportedBill) Validate() error {
+	if ib.SourceHash == "" {
+		return errors.Wrapf(ErrInvalidBillImport, "line %d: empty source hash", ib.Line)
+	}
+
+	return errors.Wrapf(ib.Bill.Validate(), "line %d", ib.Line)
+}
+
+// Строка файла, из которой не получился счёт.
+type BillImportError struct {
+	Line int
+	Err  error
+}
+
+func (e BillImportError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e BillImportError) Unwrap() error {
+	return e.Err
+}
+
+type BillImportReport struct {
+	// Созданные счета в порядке строк файла.
+	Imported []BillID
+	// Строки, загруженные раньше.
+	Skipped int
+	// Только проверка: ничего не сохранено, Pending - сколько счетов было бы создано.
+	DryRun  bool
+	Pending int
+}
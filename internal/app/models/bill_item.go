@@ -9,51 +9,194 @@ import (
 )
 
 var (
-	ErrNoShares     = errors.New("shares are empty")
-	ErrZeroQuantity = errors.New("quantity is zero")
+	ErrNoShares            = errors.New("shares are empty")
+	ErrZeroQuantity        = errors.New("quantity is zero")
+	ErrNegativeQuantity    = errors.New("quantity is negative")
+	ErrQuantityPrecision   = errors.New("quantity has more decimal places than the bill allows")
+	ErrUnknownShareMode    = errors.New("unknown share mode")
+	ErrInvalidShare        = errors.New("invalid share")
+	ErrMixedShareModes     = errors.New("weight and remainder shares can't be mixed")
+	ErrZeroTotalShare      = errors.New("total weight of shares is zero")
+	ErrShareOverAllocated  = errors.New("shares allocate more than the item price")
+	ErrShareUnderAllocated = errors.New("shares allocate less than the item price")
 )
 
+// Как задана доля в позиции.
+type ShareMode string
+
+const (
+	// Относительный вес Share. По умолчанию.
+	ShareModeWeight ShareMode = "weight"
+	// Фиксированная сумма Amount.
+	ShareModeAmount ShareMode = "amount"
+	// Percent процентов от цены позиции.
+	ShareModePercent ShareMode = "percent"
+	// Поровну из того, что осталось после сумм и процентов.
+	ShareModeRemainder ShareMode = "remainder"
+)
+
+// Количество может быть дробным: 1.35 кг сыра, 0.5 л вина. Цена позиции
+// округляется до минимальной единицы валюты, как на кассе.
 type BillItem struct {
 	Title       string
 	PricePerOne Money
-	Quantity    uint
+	Quantity    decimal.Decimal
 	Type        uint8
 	Shares      []BillShare
 }
 
+// Сначала из цены позиции вычитаются фиксированные суммы и проценты, остаток
+// делится по весам или поровну между долями ShareModeRemainder.
 type BillShare struct {
-	UserID UserID
-	Share  uint32
+	UserID  UserID
+	Share   uint32
+	Mode    ShareMode        `json:",omitempty"`
+	Amount  *Money           `json:",omitempty"`
+	Percent *decimal.Decimal `json:",omitempty"`
+}
+
+func (bs *BillShare) GetMode() ShareMode {
+	if bs.Mode == "" {
+		return ShareModeWeight
+	}
+
+	return bs.Mode
+}
+
+func (bs *BillShare) validate(currency Currency) error {
+	switch bs.GetMode() {
+	case ShareModeWeight, ShareModeRemainder:
+	case ShareModeAmount:
+		if bs.Amount == nil || !bs.Amount.IsPositive() {
+			return errors.Wrap(ErrInvalidShare, "amount must be positive")
+		}
+
+		if err := bs.Amount.Validate(currency); err != nil {
+			return errors.Wrap(err, "Amount has error")
+		}
+	case ShareModePercent:
+		if bs.Percent == nil || !bs.Percent.IsPositive() || bs.Percent.GreaterThan(decimal.NewFromInt(100)) {
+			return errors.Wrapf(ErrInvalidShare, "percent %s must be in (0, 100]", bs.Percent)
+		}
+	default:
+		return errors.Wrapf(ErrUnknownShareMode, "%q", string(bs.Mode))
+	}
+
+	return nil
 }
 
-func (bi *BillItem) Validate() error {
-	if bi.Quantity < 1 {
+func (bi *BillItem) Validate(currency Currency, quantityPrecision int32) error {
+	switch bi.Quantity.Sign() {
+	case 0:
 		return ErrZeroQuantity
+	case -1:
+		return errors.Wrapf(ErrNegativeQuantity, "%s", bi.Quantity)
+	}
+
+	if !bi.Quantity.Round(quantityPrecision).Equal(bi.Quantity) {
+		return errors.Wrapf(ErrQuantityPrecision, "%s, allowed %d", bi.Quantity, quantityPrecision)
 	}
 
 	if len(bi.Shares) == 0 {
 		return ErrNoShares
 	}
 
-	if err := bi.PricePerOne.Validate(); err != nil {
+	if err := bi.PricePerOne.Validate(currency); err != nil {
 		return errors.Wrap(err, "PricePerOne has error")
 	}
 
-	if err := bi.TotalPrice().Validate(); err != nil {
-		return errors.Wrap(err, "TotalPrice has error")
+	for index, share := range bi.Shares {
+		if err := share.validate(currency); err != nil {
+			return errors.Wrapf(err, "share at index %d is invalid", index)
+		}
+	}
+
+	alloc := bi.allocate(currency)
+	if alloc.hasWeight && alloc.hasRemainder {
+		return ErrMixedShareModes
+	}
+
+	if alloc.hasWeight && alloc.totalWeight == 0 {
+		return ErrZeroTotalShare
+	}
+
+	price := bi.TotalPrice(currency)
+	switch alloc.rest.Sign() {
+	case -1:
+		return errors.Wrapf(ErrShareOverAllocated, "allocated %s of %s",
+			alloc.fixed.FloatString(int(currency.Precision())+2), price)
+	case 1:
+		if !alloc.hasWeight && !alloc.hasRemainder {
+			return errors.Wrapf(ErrShareUnderAllocated, "allocated %s of %s, %s left",
+				alloc.fixed.FloatString(int(currency.Precision())+2), price,
+				alloc.rest.FloatString(int(currency.Precision())+2))
+		}
 	}
 
 	return nil
 }
 
-func (bi *BillItem) TotalPrice() Money {
-	return Money{bi.PricePerOne.Mul(decimal.NewFromInt(int64(bi.Quantity)))}
+// Распределение цены позиции: сколько ушло на суммы и проценты и сколько
+// осталось на веса и остаток.
+type shareAllocation struct {
+	fixed        *big.Rat
+	rest         *big.Rat
+	totalWeight  int64
+	remainders   int64
+	hasWeight    bool
+	hasRemainder bool
+}
+
+func (bi *BillItem) allocate(currency Currency) shareAllocation {
+	price := bi.TotalPrice(currency).Rat()
+	res := shareAllocation{fixed: new(big.Rat)}
+
+	for _, share := range bi.Shares {
+		switch share.GetMode() {
+		case ShareModeWeight:
+			res.hasWeight = true
+			res.totalWeight += int64(share.Share)
+		case ShareModeRemainder:
+			res.hasRemainder = true
+			res.remainders++
+		default:
+			res.fixed.Add(res.fixed, share.fixedPrice(price))
+		}
+	}
+
+	res.rest = new(big.Rat).Sub(price, res.fixed)
+	return res
+}
+
+// Цена доли в режимах ShareModeAmount и ShareModePercent.
+func (bs *BillShare) fixedPrice(price *big.Rat) *big.Rat {
+	switch bs.GetMode() {
+	case ShareModeAmount:
+		if bs.Amount != nil {
+			return bs.Amount.Rat()
+		}
+	case ShareModePercent:
+		if bs.Percent != nil {
+			part := new(big.Rat).Mul(price, bs.Percent.Rat())
+			return part.Quo(part, big.NewRat(100, 1))
+		}
+	}
+
+	return new(big.Rat)
+}
+
+// Цена за количество, округлённая до минимальной единицы валюты.
+func (bi *BillItem) TotalPrice(currency Currency) Money {
+	return Money{bi.PricePerOne.Mul(bi.Quantity).Round(currency.Precision())}
 }
 
+// Сумма весов долей ShareModeWeight.
 func (bi *BillItem) TotalShare() int64 {
 	var totalShare int64
 	for _, share := range bi.Shares {
-		totalShare += int64(share.Share)
+		if share.GetMode() == ShareModeWeight {
+			totalShare += int64(share.Share)
+		}
 	}
 
 	return totalShare
@@ -93,14 +236,26 @@ func (bi *BillItem) SimplifyShares() {
 	}
 }
 
-func (bi *BillItem) SharePricesByUser() map[UserID]MoneyRat {
+// Точная цена доли каждого пользователя. Позиция должна быть валидной.
+func (bi *BillItem) SharePricesByUser(currency Currency) map[UserID]MoneyRat {
 	res := map[UserID]MoneyRat{}
-	price := bi.TotalPrice().Rat()
-	totalShare := bi.TotalShare()
+	price := bi.TotalPrice(currency).Rat()
+	alloc := bi.allocate(currency)
 
 	for _, share := range bi.Shares {
-		ratio := big.NewRat(int64(share.Share), totalShare)
-		sharePrice := MoneyRat{NewMoneyRat().Mul(price, ratio)}
+		var sharePrice MoneyRat
+		switch share.GetMode() {
+		case ShareModeWeight:
+			if alloc.totalWeight == 0 {
+				continue
+			}
+			ratio := big.NewRat(int64(share.Share), alloc.totalWeight)
+			sharePrice = MoneyRat{NewMoneyRat().Mul(alloc.rest, ratio)}
+		case ShareModeRemainder:
+			sharePrice = MoneyRat{NewMoneyRat().Quo(alloc.rest, big.NewRat(alloc.remainders, 1))}
+		default:
+			sharePrice = MoneyRat{share.fixedPrice(price)}
+		}
 
 		old := NewMoneyRat()
 		if v, ok := res[share.UserID]; ok {
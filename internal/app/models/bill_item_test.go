@@ -0,0 +1,131 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func amountShare(userID models.UserID, amount string) models.BillShare {
+	m := money(amount)
+	return models.BillShare{UserID: userID, Mode: models.ShareModeAmount, Amount: &m}
+}
+
+func percentShare(userID models.UserID, percent string) models.BillShare {
+	p := decimal.RequireFromString(percent)
+	return models.BillShare{UserID: userID, Mode: models.ShareModePercent, Percent: &p}
+}
+
+func remainderShare(userID models.UserID) models.BillShare {
+	return models.BillShare{UserID: userID, Mode: models.ShareModeRemainder}
+}
+
+func requireSharePrice(t *testing.T, prices map[models.UserID]models.MoneyRat, userID models.UserID, want string) {
+	price := prices[userID]
+	require.Equal(t, 0, price.Cmp(decimal.RequireFromString(want).Rat()), "%s: got %s", userID, price.FloatString(4))
+}
+
+func TestBillItemShareModes(t *testing.T) {
+	require := require.New(t)
+
+	// Боб платит ровно 300, остальное поровну.
+	item := models.BillItem{
+		Title:       "pizza",
+		PricePerOne: money("1000"),
+		Quantity:    decimal.NewFromInt(1),
+		Shares:      []models.BillShare{amountShare(2, "300"), remainderShare(1), remainderShare(3)},
+	}
+	require.NoError(item.Validate(models.DefaultCurrency, models.DefaultQuantityPrecision))
+
+	prices := item.SharePricesByUser(models.DefaultCurrency)
+	requireSharePrice(t, prices, 1, "350")
+	requireSharePrice(t, prices, 2, "300")
+	requireSharePrice(t, prices, 3, "350")
+
+	// Алиса 60%, Боб 40%.
+	item.Shares = []models.BillShare{percentShare(1, "60"), percentShare(2, "40")}
+	require.NoError(item.Validate(models.DefaultCurrency, models.DefaultQuantityPrecision))
+
+	prices = item.SharePricesByUser(models.DefaultCurrency)
+	requireSharePrice(t, prices, 1, "600")
+	requireSharePrice(t, prices, 2, "400")
+
+	// Сумма, процент и остаток по весам.
+	item.Shares = []models.BillShare{amountShare(1, "100"), percentShare(2, "10"), {UserID: 3, Share: 3}, {UserID: 1, Share: 1}}
+	require.NoError(item.Validate(models.DefaultCurrency, models.DefaultQuantityPrecision))
+
+	prices = item.SharePricesByUser(models.DefaultCurrency)
+	requireSharePrice(t, prices, 1, "300") // 100 + 800 / 4
+	requireSharePrice(t, prices, 2, "100")
+	requireSharePrice(t, prices, 3, "600")
+
+	bill := models.Bill{
+		Items:    []models.BillItem{item},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("1000")}},
+	}
+	invoices, err := bill.ToInvoices()
+	require.NoError(err)
+	require.Len(invoices, 2)
+	require.True(models.InvoicesTotal(invoices).Equal(decimal.NewFromInt(700)))
+}
+
+func TestBillItemShareModesValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		shares []models.BillShare
+		isErr  error
+	}{
+		{name: "over allocated", shares: []models.BillShare{amountShare(1, "700"), percentShare(2, "40")}, isErr: models.ErrShareOverAllocated},
+		{name: "over allocated with remainder", shares: []models.BillShare{amountShare(1, "1000.01"), remainderShare(2)}, isErr: models.ErrShareOverAllocated},
+		{name: "under allocated", shares: []models.BillShare{percentShare(1, "60"), percentShare(2, "30")}, isErr: models.ErrShareUnderAllocated},
+		{name: "mixed", shares: []models.BillShare{{UserID: 1, Share: 1}, remainderShare(2)}, isErr: models.ErrMixedShareModes},
+		{name: "zero weight", shares: []models.BillShare{amountShare(1, "500"), {UserID: 2}}, isErr: models.ErrZeroTotalShare},
+		{name: "percent over 100", shares: []models.BillShare{percentShare(1, "101")}, isErr: models.ErrInvalidShare},
+		{name: "no amount", shares: []models.BillShare{{UserID: 1, Mode: models.ShareModeAmount}}, isErr: models.ErrInvalidShare},
+		{name: "amount precision", shares: []models.BillShare{amountShare(1, "0.001"), remainderShare(2)}, isErr: models.ErrMoneyPrecision},
+		{name: "unknown mode", shares: []models.BillShare{{UserID: 1, Mode: "random"}}, isErr: models.ErrUnknownShareMode},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			item := models.BillItem{Title: "pizza", PricePerOne: money("1000"), Quantity: decimal.NewFromInt(1), Shares: tc.shares}
+			require.ErrorIs(t, item.Validate(models.DefaultCurrency, models.DefaultQuantityPrecision), tc.isErr)
+		})
+	}
+}
+
+func TestBillItemFractionalQuantity(t *testing.T) {
+	require := require.New(t)
+
+	// 1.35 кг по 499.90: 674.865 -> 674.87, как на кассе.
+	bill := models.Bill{
+		Items: []models.BillItem{{
+			Title:       "cheese",
+			PricePerOne: money("499.90"),
+			Quantity:    decimal.RequireFromString("1.35"),
+			Shares:      []models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 1}},
+		}},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("674.87")}},
+	}
+	require.True(bill.TotalPrice().Equal(decimal.RequireFromString("674.87")), "got %s", bill.TotalPrice())
+	require.NoError(bill.Validate())
+
+	invoices, err := bill.ToInvoices()
+	require.NoError(err)
+	require.Len(invoices, 1)
+	require.True(invoices[0].Value.Equal(decimal.RequireFromString("337.44")), "got %s", invoices[0].Value)
+
+	bill.Items[0].Quantity = decimal.RequireFromString("1.3505")
+	require.ErrorIs(bill.Validate(), models.ErrQuantityPrecision)
+
+	bill.QuantityPrecision = 4
+	bill.Payments[0].Amount = bill.TotalPrice()
+	require.NoError(bill.Validate())
+
+	bill.QuantityPrecision = models.MaxQuantityPrecision + 1
+	require.ErrorIs(bill.Validate(), models.ErrQuantityPrecisionRange)
+
+	bill.QuantityPrecision = 0
+	bill.Items[0].Quantity = decimal.RequireFromString("-1")
+	require.ErrorIs(bill.Validate(), models.ErrNegativeQuantity)
+}
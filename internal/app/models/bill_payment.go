@@ -7,8 +7,8 @@ type BillPayment struct {
 	Amount Money
 }
 
-func (bp *BillPayment) Validate() error {
-	if err := bp.Amount.Validate(); err != nil {
+func (bp *BillPayment) Validate(currency Currency) error {
+	if err := bp.Amount.Validate(currency); err != nil {
 		return errors.Wrapf(err, "Amount precision more than money")
 	}
 
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Валюта по ISO 4217.
+type Currency string
+
+const (
+	// Валюта счетов, записанных до появления мультивалютности.
+	DefaultCurrency Currency = "RUB"
+)
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// Число знаков после запятой (minor units) по ISO 4217.
+var currencyPrecisions = map[Currency]int32{
+	"AED": 2, "AMD": 2, "AUD": 2, "AZN": 2, "BGN": 2, "BHD": 3, "BRL": 2,
+	"BYN": 2, "CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2, "CZK": 2, "DKK": 2,
+	"EGP": 2, "EUR": 2, "GBP": 2, "GEL": 2, "HKD": 2, "HUF": 2, "IDR": 2,
+	"ILS": 2, "INR": 2, "IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KGS": 2,
+	"KRW": 0, "KWD": 3, "KZT": 2, "LYD": 3, "MDL": 2, "MNT": 2, "MXN": 2,
+	"NOK": 2, "NZD": 2, "OMR": 3, "PLN": 2, "RON": 2, "RSD": 2, "RUB": 2,
+	"SEK": 2, "SGD": 2, "THB": 2, "TJS": 2, "TND": 3, "TRY": 2, "UAH": 2,
+	"USD": 2, "UZS": 2, "VND": 0, "ZAR": 2,
+}
+
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+
+	return c, nil
+}
+
+func (c Currency) Validate() error {
+	if _, ok := currencyPrecisions[c]; !ok {
+		return errors.Wrapf(ErrUnknownCurrency, "%q", string(c))
+	}
+
+	return nil
+}
+
+// Пустая валюта - DefaultCurrency, так лежат старые счета.
+func (c Currency) OrDefault() Currency {
+	if c == "" {
+		return DefaultCurrency
+	}
+
+	return c
+}
+
+// Знаков после запятой. Для неизвестной валюты - как у DefaultCurrency,
+// поэтому валюту на входе надо проверять через Validate.
+func (c Currency) Precision() int32 {
+	if p, ok := currencyPrecisions[c.OrDefault()]; ok {
+		return p
+	}
+
+	return currencyPrecisions[DefaultCurrency]
+}
+
+func (c Currency) String() string {
+	return string(c.OrDefault())
+}
+
+func (c Currency) GoString() string {
+	return fmt.Sprintf("Currency(%s)", c.String())
+}
+
+// Балансы пользователей отдельно по каждой валюте.
+type BalancesByCurrency map[Currency]map[UserID]Money
+
+func (b BalancesByCurrency) Add(c Currency, userID UserID, m Money) {
+	byUser, ok := b[c]
+	if !ok {
+		byUser = map[UserID]Money{}
+		b[c] = byUser
+	}
+
+	old, ok := byUser[userID]
+	if !ok {
+		old = NewMoney()
+	}
+
+	byUser[userID] = Money{old.Add(m.Decimal)}
+}
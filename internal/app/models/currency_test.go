@@ -0,0 +1,56 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyValidateCurrency(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(money("100").Validate("JPY"))
+	require.ErrorIs(money("100.5").Validate("JPY"), models.ErrMoneyPrecision)
+	require.NoError(money("1.125").Validate("KWD"))
+	require.ErrorIs(money("1.1255").Validate("KWD"), models.ErrMoneyPrecision)
+	// Незначащие нули не мешают.
+	require.NoError(money("1.500").Validate(models.DefaultCurrency))
+
+	_, err := models.ParseCurrency("usd")
+	require.NoError(err)
+	_, err = models.ParseCurrency("ABC")
+	require.ErrorIs(err, models.ErrUnknownCurrency)
+}
+
+func TestBillToInvoicesCurrency(t *testing.T) {
+	require := require.New(t)
+
+	// 100 иен на троих: 33.(3) каждому, в иенах дробей нет.
+	bill := models.Bill{
+		Currency: "JPY",
+		Items: []models.BillItem{{
+			Title:       "ramen",
+			PricePerOne: money("100"),
+			Quantity:    decimal.NewFromInt(1),
+			Shares:      []models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 1}, {UserID: 3, Share: 1}},
+		}},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("100")}},
+	}
+	require.NoError(bill.Validate())
+
+	invoices, err := bill.ToInvoices()
+	require.NoError(err)
+	require.Len(invoices, 2)
+	for _, inv := range invoices {
+		require.Equal(models.Currency("JPY"), inv.Currency)
+		require.NoError(inv.Value.Validate("JPY"), "invoice %s", inv.Value)
+	}
+
+	bill.Payments[0].Amount = money("100.5")
+	require.ErrorIs(bill.Validate(), models.ErrMoneyPrecision)
+
+	bill.Currency = "XXX"
+	require.ErrorIs(bill.Validate(), models.ErrUnknownCurrency)
+}
@@ -0,0 +1,179 @@
+package models
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+	ErrInvalidExchangeRate  = errors.New("invalid exchange rate")
+)
+
+// Курс на дату: 1 Base = Rate Quote.
+type ExchangeRate struct {
+	Base  Currency
+	Quote Currency
+	Date  time.Time
+	Rate  decimal.Decimal
+}
+
+func (r *ExchangeRate) Validate() error {
+	if err := r.Base.Validate(); err != nil {
+		return errors.Wrap(err, "base")
+	}
+
+	if err := r.Quote.Validate(); err != nil {
+		return errors.Wrap(err, "quote")
+	}
+
+	if r.Base == r.Quote {
+		return errors.Wrapf(ErrInvalidExchangeRate, "same currency %s", r.Base)
+	}
+
+	if r.Date.IsZero() {
+		return errors.Wrap(ErrInvalidExchangeRate, "date is empty")
+	}
+
+	if !r.Rate.IsPositive() {
+		return errors.Wrapf(ErrInvalidExchangeRate, "rate %s is not positive", r.Rate)
+	}
+
+	return nil
+}
+
+// Курсы бывают только на день, время отбрасываем.
+func RateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Набор курсов, актуальных на одну дату: не больше одного курса на пару.
+type ExchangeRates []ExchangeRate
+
+func (rs ExchangeRates) find(base, quote Currency) (ExchangeRate, bool) {
+	for _, r := range rs {
+		if r.Base == base && r.Quote == quote {
+			return r, true
+		}
+	}
+
+	return ExchangeRate{}, false
+}
+
+// Сколько единиц to в одной единице from. Сначала ищем прямой курс, потом
+// обратный, потом кросс-курс через DefaultCurrency. Возвращает и курсы,
+// которые пошли в расчёт.
+func (rs ExchangeRates) Rate(from, to Currency) (MoneyRat, []ExchangeRate, error) {
+	from, to = from.OrDefault(), to.OrDefault()
+	if from == to {
+		return MoneyRat{big.NewRat(1, 1)}, nil, nil
+	}
+
+	if r, ok := rs.find(from, to); ok {
+		return MoneyRat{r.Rate.Rat()}, []ExchangeRate{r}, nil
+	}
+
+	if r, ok := rs.find(to, from); ok {
+		return MoneyRat{new(big.Rat).Inv(r.Rate.Rat())}, []ExchangeRate{r}, nil
+	}
+
+	if from != DefaultCurrency && to != DefaultCurrency {
+		toPivot, fromUsed, err := rs.Rate(from, DefaultCurrency)
+		if err == nil {
+			fromPivot, toUsed, err := rs.Rate(DefaultCurrency, to)
+			if err == nil {
+				rate := NewMoneyRat()
+				rate.Mul(toPivot.Rat, fromPivot.Rat)
+				return rate, append(fromUsed, toUsed...), nil
+			}
+		}
+	}
+
+	return MoneyRat{}, nil, errors.Wrapf(ErrExchangeRateNotFound, "%s to %s", from, to)
+}
+
+// Балансы с контрагентами, пересчитанные в одну валюту.
+type ConvertedBalances struct {
+	Currency Currency
+	Date     time.Time
+	Balances map[UserID]Money
+	// Курсы, которые пошли в расчёт, по порядку пар.
+	Rates []ExchangeRate
+	// Точная сумма минус сумма округлённых балансов.
+	Residue MoneyRat
+}
+
+// Пересчёт балансов в валюту target. Суммы по контрагенту складываются
+// точно и округляются один раз, в самом конце.
+func ConvertBalances(balances BalancesByCurrency, target Currency, date time.Time, rates ExchangeRates) (ConvertedBalances, error) {
+	target = target.OrDefault()
+	if err := target.Validate(); err != nil {
+		return ConvertedBalances{}, err
+	}
+
+	currencies := make([]Currency, 0, len(balances))
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i] < currencies[j]
+	})
+
+	exact := map[UserID]MoneyRat{}
+	used := map[[2]Currency]ExchangeRate{}
+	for _, currency := range currencies {
+		rate, rateUsed, err := rates.Rate(currency, target)
+		if err != nil {
+			return ConvertedBalances{}, err
+		}
+
+		for _, r := range rateUsed {
+			used[[2]Currency{r.Base, r.Quote}] = r
+		}
+
+		for userID, m := range balances[currency] {
+			sum, ok := exact[userID]
+			if !ok {
+				sum = NewMoneyRat()
+				exact[userID] = sum
+			}
+
+			converted := NewMoneyRat()
+			converted.Mul(m.Rat(), rate.Rat)
+			sum.Add(sum.Rat, converted.Rat)
+		}
+	}
+
+	res := ConvertedBalances{
+		Currency: target,
+		Date:     RateDate(date),
+		Balances: make(map[UserID]Money, len(exact)),
+		Rates:    make([]ExchangeRate, 0, len(used)),
+		Residue:  NewMoneyRat(),
+	}
+
+	for userID, sum := range exact {
+		rounded := sum.Money(target)
+		res.Balances[userID] = rounded
+
+		res.Residue.Add(res.Residue.Rat, sum.Rat)
+		res.Residue.Sub(res.Residue.Rat, rounded.Rat())
+	}
+
+	for _, r := range used {
+		res.Rates = append(res.Rates, r)
+	}
+	sort.Slice(res.Rates, func(i, j int) bool {
+		if res.Rates[i].Base != res.Rates[j].Base {
+			return res.Rates[i].Base < res.Rates[j].Base
+		}
+		return res.Rates[i].Quote < res.Rates[j].Quote
+	})
+
+	return res, nil
+}
@@ -0,0 +1,66 @@
+package models_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func rate(base, quote models.Currency, v string) models.ExchangeRate {
+	return models.ExchangeRate{
+		Base:  base,
+		Quote: quote,
+		Date:  time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		Rate:  decimal.RequireFromString(v),
+	}
+}
+
+func TestExchangeRatesRate(t *testing.T) {
+	require := require.New(t)
+
+	rates := models.ExchangeRates{
+		rate("USD", "RUB", "75"),
+		rate("EUR", "RUB", "80"),
+	}
+
+	r, used, err := rates.Rate("RUB", "USD")
+	require.NoError(err)
+	require.Equal(0, r.Cmp(big.NewRat(1, 75)))
+	require.Len(used, 1)
+
+	// Кросс-курс через рубль.
+	r, used, err = rates.Rate("USD", "EUR")
+	require.NoError(err)
+	require.Equal(0, r.Cmp(big.NewRat(75, 80)))
+	require.Len(used, 2)
+
+	_, _, err = rates.Rate("USD", "JPY")
+	require.ErrorIs(err, models.ErrExchangeRateNotFound)
+}
+
+func TestConvertBalances(t *testing.T) {
+	require := require.New(t)
+
+	balances := models.BalancesByCurrency{}
+	balances.Add("RUB", 2, money("100"))
+	balances.Add("USD", 2, money("-1"))
+	balances.Add("RUB", 3, money("-50"))
+
+	converted, err := models.ConvertBalances(balances, "USD", time.Now(), models.ExchangeRates{rate("USD", "RUB", "75")})
+	require.NoError(err)
+
+	// 100/75 - 1 = 0.(3) -> 0.33, -50/75 = -0.(6) -> -0.67.
+	require.True(converted.Balances[2].Equal(decimal.RequireFromString("0.33")), "got %s", converted.Balances[2])
+	require.True(converted.Balances[3].Equal(decimal.RequireFromString("-0.67")), "got %s", converted.Balances[3])
+	require.Len(converted.Rates, 1)
+
+	// (1/3 - 0.33) + (-2/3 + 0.67) = 1/300 + 1/300.
+	require.Equal(0, converted.Residue.Cmp(big.NewRat(1, 150)), "got %s", converted.Residue)
+
+	_, err = models.ConvertBalances(balances, "JPY", time.Now(), models.ExchangeRates{rate("USD", "RUB", "75")})
+	require.ErrorIs(err, models.ErrExchangeRateNotFound)
+}
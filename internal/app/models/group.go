@@ -0,0 +1,175 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrGroupNotFound      = errors.New("group not found")
+	ErrInvalidGroup       = errors.New("invalid group")
+	ErrUnknownGroupRole   = errors.New("unknown group role")
+	ErrNotGroupMember     = errors.New("user is not a member of the group")
+	ErrAlreadyGroupMember = errors.New("user is already a member of the group")
+	ErrLastGroupOwner     = errors.New("group must have an owner")
+	ErrMemberHasBalance   = errors.New("member has unsettled balance in the group")
+)
+
+// Компания для общих счетов: квартира, поездка. Счета и погашения группы
+// дают отдельные от личных балансы.
+type Group struct {
+	ID        GroupID
+	Title     string
+	CreatedBy UserID
+	CreatedAt time.Time
+	// По возрастанию UserID.
+	Members []GroupMember
+}
+
+type GroupID int64
+
+func (gid GroupID) String() string {
+	return fmt.Sprintf("GroupID(%d)", gid)
+}
+
+// Роль в группе. Владелец и администраторы управляют составом.
+type GroupRole string
+
+const (
+	GroupRoleOwner  GroupRole = "owner"
+	GroupRoleAdmin  GroupRole = "admin"
+	GroupRoleMember GroupRole = "member"
+)
+
+func (r GroupRole) Validate() error {
+	switch r {
+	case GroupRoleOwner, GroupRoleAdmin, GroupRoleMember:
+		return nil
+	}
+
+	return errors.Wrapf(ErrUnknownGroupRole, "%q", string(r))
+}
+
+func (r GroupRole) CanManage() bool {
+	return r == GroupRoleOwner || r == GroupRoleAdmin
+}
+
+type GroupMember struct {
+	UserID   UserID
+	Role     GroupRole
+	JoinedAt time.Time
+}
+
+func (g *Group) Validate() error {
+	if strings.TrimSpace(g.Title) == "" {
+		return errors.Wrap(ErrInvalidGroup, "title is empty")
+	}
+
+	hasOwner := false
+	seen := map[UserID]struct{}{}
+	for _, m := range g.Members {
+		if err := m.Role.Validate(); err != nil {
+			return errors.Wrapf(err, "member %s", m.UserID)
+		}
+
+		if _, ok := seen[m.UserID]; ok {
+			return errors.Wrapf(ErrAlreadyGroupMember, "%s", m.UserID)
+		}
+		seen[m.UserID] = struct{}{}
+
+		hasOwner = hasOwner || m.Role == GroupRoleOwner
+	}
+
+	if !hasOwner {
+		return ErrLastGroupOwner
+	}
+
+	return nil
+}
+
+func (g *Group) Member(userID UserID) (GroupMember, bool) {
+	for _, m := range g.Members {
+		if m.UserID == userID {
+			return m, true
+		}
+	}
+
+	return GroupMember{}, false
+}
+
+func (g *Group) HasMember(userID UserID) bool {
+	_, ok := g.Member(userID)
+	return ok
+}
+
+func (g *Group) MemberIDs() []UserID {
+	res := make([]UserID, 0, len(g.Members))
+	for _, m := range g.Members {
+		res = append(res, m.UserID)
+	}
+
+	return res
+}
+
+// Все участники счёта должны состоять в группе.
+func (g *Group) CheckBillMembers(bill Bill) error {
+	userIDs := make([]UserID, 0)
+	for userID := range bill.Participants() {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	for _, userID := range userIDs {
+		if !g.HasMember(userID) {
+			return errors.Wrapf(ErrNotGroupMember, "%s in %s", userID, g.ID)
+		}
+	}
+
+	return nil
+}
+
+// Состав группы после смены роли или удаления участника. Владелец
+// у группы остаётся всегда.
+func (g *Group) WithMember(member GroupMember) (Group, error) {
+	if err := member.Role.Validate(); err != nil {
+		return Group{}, err
+	}
+
+	res := *g
+	res.Members = make([]GroupMember, 0, len(g.Members)+1)
+	replaced := false
+	for _, m := range g.Members {
+		if m.UserID == member.UserID {
+			m.Role = member.Role
+			replaced = true
+		}
+		res.Members = append(res.Members, m)
+	}
+
+	if !replaced {
+		res.Members = append(res.Members, member)
+		sort.Slice(res.Members, func(i, j int) bool { return res.Members[i].UserID < res.Members[j].UserID })
+	}
+
+	return res, res.Validate()
+}
+
+func (g *Group) WithoutMember(userID UserID) (Group, error) {
+	if !g.HasMember(userID) {
+		return Group{}, errors.Wrapf(ErrNotGroupMember, "%s in %s", userID, g.ID)
+	}
+
+	res := *g
+	res.Members = make([]GroupMember, 0, len(g.Members))
+	for _, m := range g.Members {
+		if m.UserID != userID {
+			res.Members = append(res.Members, m)
+		}
+	}
+
+	return res, res.Validate()
+}
@@ -0,0 +1,85 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/stretchr/testify/require"
+)
+
+func testGroup() models.Group {
+	return models.Group{
+		ID:    1,
+		Title: "Поездка",
+		Members: []models.GroupMember{
+			{UserID: 1, Role: models.GroupRoleOwner},
+			{UserID: 3, Role: models.GroupRoleMember},
+		},
+	}
+}
+
+func TestGroupValidate(t *testing.T) {
+	require := require.New(t)
+
+	group := testGroup()
+	require.NoError(group.Validate())
+
+	group.Title = " "
+	require.ErrorIs(group.Validate(), models.ErrInvalidGroup)
+
+	group = testGroup()
+	group.Members[0].Role = models.GroupRoleAdmin
+	require.ErrorIs(group.Validate(), models.ErrLastGroupOwner)
+
+	group = testGroup()
+	group.Members[1].Role = "guest"
+	require.ErrorIs(group.Validate(), models.ErrUnknownGroupRole)
+
+	group = testGroup()
+	group.Members = append(group.Members, models.GroupMember{UserID: 3, Role: models.GroupRoleAdmin})
+	require.ErrorIs(group.Validate(), models.ErrAlreadyGroupMember)
+}
+
+func TestGroupMembers(t *testing.T) {
+	require := require.New(t)
+	group := testGroup()
+
+	added, err := group.WithMember(models.GroupMember{UserID: 2, Role: models.GroupRoleAdmin})
+	require.NoError(err)
+	require.Equal([]models.UserID{1, 2, 3}, added.MemberIDs())
+	require.Equal([]models.UserID{1, 3}, group.MemberIDs())
+
+	// Смена роли не меняет состав.
+	promoted, err := added.WithMember(models.GroupMember{UserID: 3, Role: models.GroupRoleOwner})
+	require.NoError(err)
+	require.Equal([]models.UserID{1, 2, 3}, promoted.MemberIDs())
+
+	_, err = group.WithMember(models.GroupMember{UserID: 1, Role: models.GroupRoleMember})
+	require.ErrorIs(err, models.ErrLastGroupOwner)
+
+	_, err = group.WithoutMember(1)
+	require.ErrorIs(err, models.ErrLastGroupOwner)
+
+	_, err = group.WithoutMember(2)
+	require.ErrorIs(err, models.ErrNotGroupMember)
+
+	removed, err := promoted.WithoutMember(1)
+	require.NoError(err)
+	require.Equal([]models.UserID{2, 3}, removed.MemberIDs())
+}
+
+func TestGroupCheckBillMembers(t *testing.T) {
+	require := require.New(t)
+	group := testGroup()
+
+	bill := models.Bill{
+		Items: []models.BillItem{
+			{Title: "Бензин", Shares: []models.BillShare{{UserID: 1, Share: 1}, {UserID: 3, Share: 1}}},
+		},
+		Payments: []models.BillPayment{{UserID: 1}},
+	}
+	require.NoError(group.CheckBillMembers(bill))
+
+	bill.Payments = []models.BillPayment{{UserID: 2}}
+	require.ErrorIs(group.CheckBillMembers(bill), models.ErrNotGroupMember)
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInviteNotFound = errors.New("invite not found")
+	ErrInviteExpired  = errors.New("invite is expired")
+	ErrInviteClaimed  = errors.New("invite is already claimed")
+	ErrInvalidInvite  = errors.New("invalid invite")
+)
+
+const inviteCodeBytes = 10
+
+// Код приглашения: 16 символов base32 без паддинга.
+type InviteCode string
+
+var inviteEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func NewInviteCode() (InviteCode, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return InviteCode(inviteEncoding.EncodeToString(b)), nil
+}
+
+// Коды вводят руками, поэтому регистр и пробелы по краям не важны.
+func NormalizeInviteCode(code string) InviteCode {
+	return InviteCode(strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// Ссылка-приглашение. Без linkBase ссылки нет, только код.
+func (c InviteCode) Link(linkBase string) string {
+	if linkBase == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(linkBase, "/") + "/" + string(c)
+}
+
+// Приглашение в группу, на заглушку или в группу вместе с заглушкой. Тот, кто
+// его принял, становится участником группы и забирает себе счета и долги
+// заглушки.
+type Invite struct {
+	Code          InviteCode
+	GroupID       GroupID
+	PlaceholderID UserID
+	CreatedBy     UserID
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	// 0 - приглашение ещё не принято.
+	ClaimedBy UserID
+	ClaimedAt time.Time
+}
+
+func (i *Invite) Validate() error {
+	if i.GroupID == 0 && i.PlaceholderID == 0 {
+		return errors.Wrap(ErrInvalidInvite, "neither group nor placeholder")
+	}
+
+	if !i.ExpiresAt.After(i.CreatedAt) {
+		return errors.Wrap(ErrInvalidInvite, "expires before created")
+	}
+
+	return nil
+}
+
+// Принять приглашение можно один раз и до ExpiresAt.
+func (i *Invite) CheckClaimable(userID UserID, now time.Time) error {
+	if i.ClaimedBy != 0 {
+		return errors.Wrapf(ErrInviteClaimed, "by %s", i.ClaimedBy)
+	}
+
+	if !now.Before(i.ExpiresAt) {
+		return errors.Wrapf(ErrInviteExpired, "at %s", i.ExpiresAt)
+	}
+
+	if userID == i.PlaceholderID {
+		return errors.Wrap(ErrInvalidInvite, "placeholder can't claim itself")
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInviteCode(t *testing.T) {
+	require := require.New(t)
+
+	code, err := models.NewInviteCode()
+	require.NoError(err)
+	require.Len(string(code), 16)
+	require.Equal(code, models.NormalizeInviteCode(" "+string(code)+"\n"))
+
+	other, err := models.NewInviteCode()
+	require.NoError(err)
+	require.NotEqual(code, other)
+
+	require.Equal("", code.Link(""))
+	require.Equal("https://example.org/invite/"+string(code), code.Link("https://example.org/invite/"))
+}
+
+func TestInviteCheckClaimable(t *testing.T) {
+	require := require.New(t)
+	now := time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	invite := models.Invite{
+		PlaceholderID: 5,
+		CreatedAt:     now.Add(-time.Hour),
+		ExpiresAt:     now.Add(time.Hour),
+	}
+	require.NoError(invite.Validate())
+	require.NoError(invite.CheckClaimable(1, now))
+	require.ErrorIs(invite.CheckClaimable(5, now), models.ErrInvalidInvite)
+	require.ErrorIs(invite.CheckClaimable(1, now.Add(time.Hour)), models.ErrInviteExpired)
+
+	invite.ClaimedBy = 2
+	require.ErrorIs(invite.CheckClaimable(1, now), models.ErrInviteClaimed)
+
+	require.ErrorIs((&models.Invite{CreatedAt: now, ExpiresAt: now.Add(time.Hour)}).Validate(), models.ErrInvalidInvite)
+	require.ErrorIs((&models.Invite{GroupID: 1, CreatedAt: now, ExpiresAt: now}).Validate(), models.ErrInvalidInvite)
+}
+
+func TestBillWithUserReplaced(t *testing.T) {
+	require := require.New(t)
+
+	// Заглушка 5 и пользователь 2 делят пиццу, платят оба, на надбавку - 2 и 5.
+	bill := models.Bill{
+		Items: []models.BillItem{
+			{
+				Title:       "Пицца",
+				PricePerOne: money("300"),
+				Quantity:    decimal.NewFromInt(1),
+				Shares: []models.BillShare{
+					{UserID: 1, Share: 1},
+					{UserID: 2, Share: 1},
+					{UserID: 5, Share: 1},
+				},
+			},
+			{
+				Title:       "Вино",
+				PricePerOne: money("100"),
+				Quantity:    decimal.NewFromInt(1),
+				Shares:      []models.BillShare{amountShare(2, "30"), amountShare(5, "70")},
+			},
+		},
+		Adjustments: []models.BillAdjustment{
+			{Title: "Доставка", Kind: models.AdjustmentServiceCharge, Amount: money("20"), Split: models.AdjustmentSplitEqual, UserIDs: []models.UserID{2, 5}},
+		},
+		Payments: []models.BillPayment{
+			{UserID: 2, Amount: money("200")},
+			{UserID: 5, Amount: money("220")},
+		},
+	}
+	require.NoError(bill.Validate())
+
+	replaced := bill.WithUserReplaced(5, 2)
+	require.NoError(replaced.Validate())
+
+	require.Equal([]models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 2}}, replaced.Items[0].Shares)
+	require.Len(replaced.Items[1].Shares, 1)
+	require.True(replaced.Items[1].Shares[0].Amount.Equal(decimal.NewFromInt(100)))
+	require.Equal([]models.UserID{2}, replaced.Adjustments[0].UserIDs)
+	require.Len(replaced.Payments, 1)
+	require.True(replaced.Payments[0].Amount.Equal(decimal.NewFromInt(420)))
+
+	// Исходный счёт не меняется.
+	require.Equal(models.UserID(5), bill.Items[0].Shares[2].UserID)
+	require.Len(bill.Payments, 2)
+
+	// Долг 1 перед 2 - сумма долгов перед 2 и 5.
+	invoices, err := replaced.ToInvoices()
+	require.NoError(err)
+	require.Len(invoices, 1)
+	require.Equal(models.UserID(2), invoices[0].UserFrom)
+	require.Equal(models.UserID(1), invoices[0].UserTo)
+	require.True(invoices[0].Value.Equal(decimal.NewFromInt(100)))
+}
@@ -2,7 +2,6 @@ package models
 
 import (
 	"math/big"
-	"math/rand"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -13,7 +12,28 @@ var (
 	ErrBalanceNotZero = errors.New("balance isn't zero")
 )
 
-func InvoicesFromBalances(balancesMap map[UserID]MoneyRat) ([]Invoice, error) {
+func InvoicesFromBalances(balancesMap map[UserID]MoneyRat, currency Currency) ([]Invoice, error) {
+	exact, err := ratInvoicesFromBalances(balancesMap, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices := make([]Invoice, 0, len(exact))
+	for _, inv := range exact {
+		inv.Value = inv.Exact.Money(currency)
+		invoices = append(invoices, inv.Invoice)
+	}
+
+	return invoices, nil
+}
+
+// Инвойс с точной суммой, до округления. Value не заполнено.
+type ratInvoice struct {
+	Invoice
+	Exact MoneyRat
+}
+
+func ratInvoicesFromBalances(balancesMap map[UserID]MoneyRat, currency Currency) ([]ratInvoice, error) {
 	zeroRat := big.NewRat(0, 1)
 
 	type userMoneyRat struct {
@@ -37,12 +57,16 @@ func InvoicesFromBalances(balancesMap map[UserID]MoneyRat) ([]Invoice, error) {
 		return nil, ErrBalanceNotZero
 	}
 
+	// При равных суммах - по UserID, чтобы пары не зависели от обхода map.
 	sort.Slice(balances, func(i, j int) bool {
-		return balances[i].Amount.Cmp(balances[j].Amount.Rat) == 1
+		if c := balances[i].Amount.Cmp(balances[j].Amount.Rat); c != 0 {
+			return c == 1
+		}
+		return balances[i].UserID < balances[j].UserID
 	})
 
-	// balances -> []Invoice
-	invoices := []Invoice{}
+	// balances -> []ratInvoice
+	invoices := []ratInvoice{}
 	var i, j int = 0, len(balances) - 1
 	for i < j {
 		credit := balances[i]
@@ -69,10 +93,13 @@ func InvoicesFromBalances(balancesMap map[UserID]MoneyRat) ([]Invoice, error) {
 			debited = debtAbs
 		}
 
-		invoices = append(invoices, Invoice{
-			UserFrom: credit.UserID,
-			UserTo:   debt.UserID,
-			Value:    debited.Money(),
+		invoices = append(invoices, ratInvoice{
+			Invoice: Invoice{
+				UserFrom: credit.UserID,
+				UserTo:   debt.UserID,
+				Currency: currency,
+			},
+			Exact: debited.Copy(),
 		})
 
 		credit.Amount.Sub(credit.Amount.Rat, debited.Rat)
@@ -102,6 +129,56 @@ func InvoicesFromBalances(balancesMap map[UserID]MoneyRat) ([]Invoice, error) {
 	return invoices, nil
 }
 
+// Встречные инвойсы, которые обнуляют invoices: по каждой паре пользователей
+// и валюте - один инвойс на чистую сумму в обратную сторону.
+func ReverseInvoices(invoices []Invoice) []Invoice {
+	type pairKey struct {
+		low, high UserID
+		currency  Currency
+	}
+
+	// Сколько high должен low.
+	net := map[pairKey]decimal.Decimal{}
+	keys := []pairKey{}
+	for _, inv := range invoices {
+		key := pairKey{low: inv.UserFrom, high: inv.UserTo, currency: inv.Currency.OrDefault()}
+		value := inv.Value.Decimal
+		if key.low > key.high {
+			key.low, key.high = key.high, key.low
+			value = value.Neg()
+		}
+
+		old, ok := net[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		net[key] = old.Add(value)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		if keys[i].low != keys[j].low {
+			return keys[i].low < keys[j].low
+		}
+		if keys[i].high != keys[j].high {
+			return keys[i].high < keys[j].high
+		}
+		return keys[i].currency < keys[j].currency
+	})
+
+	res := make([]Invoice, 0, len(keys))
+	for _, key := range keys {
+		value := net[key]
+		switch value.Sign() {
+		case 1:
+			res = append(res, Invoice{UserFrom: key.high, UserTo: key.low, Value: Money{value}, Currency: key.currency})
+		case -1:
+			res = append(res, Invoice{UserFrom: key.low, UserTo: key.high, Value: Money{value.Neg()}, Currency: key.currency})
+		}
+	}
+
+	return res
+}
+
 func InvoicesTotal(invoices []Invoice) Money {
 	total := NewMoney()
 	for _, invoice := range invoices {
@@ -111,38 +188,27 @@ func InvoicesTotal(invoices []Invoice) Money {
 	return total
 }
 
-func FixInvocesTotal(invoices []Invoice, targetTotal Money) ([]Invoice, error) {
-	if err := targetTotal.Validate(); err != nil {
+// Доводит сумму инвойсов до targetTotal: по одной минимальной единице валюты
+// инвойсам в порядке order, по кругу. Инвойсы с нулевой суммой отбрасываются.
+func FixInvocesTotal(invoices []Invoice, targetTotal Money, currency Currency, order []int) ([]Invoice, error) {
+	if err := targetTotal.Validate(currency); err != nil {
 		return nil, errors.Wrap(err, "target total has error")
 	}
 
 	invoicesTotal := InvoicesTotal(invoices)
 	discrepancy := targetTotal.Sub(invoicesTotal.Decimal)
 
-	if discrepancy.IsZero() {
-		return invoices, nil
-	}
-
-	invoiceCount := len(invoices)
-	fixStep := discrepancy.DivRound(decimal.NewFromInt(int64(invoiceCount)), targetTotal.Exponent())
-	minStep := decimal.New(int64(fixStep.Sign()), MoneyPrecision)
-
-	if discrepancy.Abs().Cmp(minStep.Abs()) == -1 {
-		return nil, errors.Wrapf(ErrInternalAssertion, "fix value (:s) is less than (%s)", discrepancy, minStep)
-	}
-
-	fixStep = decimal.Max(fixStep.Abs(), minStep.Abs()).
-		Mul(decimal.NewFromInt(int64(fixStep.Sign())))
-
-	remainderFix := discrepancy.Copy()
-	for i := 0; i < invoiceCount && !remainderFix.IsZero(); i++ {
-		invoices[i].Value = Money{invoices[i].Value.Add(fixStep)}
-		remainderFix = remainderFix.Sub(fixStep)
-	}
+	if !discrepancy.IsZero() {
+		if len(order) == 0 {
+			return nil, errors.Wrapf(ErrInternalAssertion, "no invoices to fix discrepancy (%s)", discrepancy)
+		}
 
-	if !remainderFix.IsZero() {
-		index := rand.Int() % invoiceCount
-		invoices[index].Value = Money{invoices[index].Value.Add(remainderFix)}
+		step := decimal.New(int64(discrepancy.Sign()), -currency.Precision())
+		steps := discrepancy.Div(step).IntPart()
+		for k := int64(0); k < steps; k++ {
+			index := order[k%int64(len(order))]
+			invoices[index].Value = Money{invoices[index].Value.Add(step)}
+		}
 	}
 
 	// проверка суммы инвойсов после исправления
@@ -0,0 +1,29 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseInvoices(t *testing.T) {
+	require := require.New(t)
+
+	invoices := []models.Invoice{
+		{UserFrom: 1, UserTo: 2, Value: money("100"), Currency: models.DefaultCurrency},
+		{UserFrom: 2, UserTo: 1, Value: money("30"), Currency: models.DefaultCurrency},
+		{UserFrom: 1, UserTo: 3, Value: money("50"), Currency: "USD"},
+		// Уже обнулённая пара.
+		{UserFrom: 3, UserTo: 2, Value: money("10")},
+		{UserFrom: 2, UserTo: 3, Value: money("10"), Currency: models.DefaultCurrency},
+	}
+
+	reversed := models.ReverseInvoices(invoices)
+	require.Equal([]models.Invoice{
+		{UserFrom: 2, UserTo: 1, Value: money("70"), Currency: models.DefaultCurrency},
+		{UserFrom: 3, UserTo: 1, Value: money("50"), Currency: "USD"},
+	}, reversed)
+
+	require.Empty(models.ReverseInvoices(append(invoices, reversed...)))
+}
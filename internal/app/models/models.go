@@ -4,4 +4,5 @@ type Invoice struct {
 	UserFrom UserID
 	UserTo   UserID
 	Value    Money
+	Currency Currency
 }
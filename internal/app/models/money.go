@@ -1,7 +1,6 @@
 package models
 
 import (
-	"math"
 	"math/big"
 
 	"github.com/pkg/errors"
@@ -9,16 +8,18 @@ import (
 )
 
 const (
+	// Точность DefaultCurrency.
 	MoneyPrecision = 2
 )
 
 var (
-	ErrMoneyPrecision = errors.Errorf("target's exponent greater then %d", MoneyPrecision)
+	ErrMoneyPrecision = errors.New("amount has more decimal places than the currency allows")
 )
 
 // Деньги для БД. Конечный результат цепочки вычислений. То, сколько нужно пересести.
 // Рациональное число может быть не выразимо в десятичной системе счисления.
 // Если требуется отдать 100/3 рублей, то в decimal это 33.33 и 0.00(3) - невязка.
+// Валюта хранится рядом (в счёте, в проводке), точность зависит от неё.
 type Money struct{ decimal.Decimal }
 
 func NewMoney() Money {
@@ -29,9 +30,10 @@ func NewMoneyFromBig(v *big.Int) Money {
 	return Money{NewMoney().Add(decimal.NewFromBigInt(v, 0))}
 }
 
-func (m Money) Validate() error {
-	if math.Abs(float64(m.Exponent())) > MoneyPrecision {
-		return ErrMoneyPrecision
+// Сумма не должна быть точнее минимальной единицы валюты.
+func (m Money) Validate(c Currency) error {
+	if !m.Round(c.Precision()).Equal(m.Decimal) {
+		return errors.Wrapf(ErrMoneyPrecision, "%s %s", m, c)
 	}
 	return nil
 }
@@ -50,11 +52,12 @@ func (m *MoneyRat) Copy() MoneyRat {
 	return ret
 }
 
-func (m *MoneyRat) Money() Money {
+// Округление до минимальной единицы валюты.
+func (m *MoneyRat) Money(c Currency) Money {
 	ret := NewMoneyFromBig(m.Num()).
 		DivRound(
 			NewMoneyFromBig(m.Denom()).Decimal,
-			MoneyPrecision,
+			c.Precision(),
 		)
 
 	return Money{ret}
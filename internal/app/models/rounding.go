@@ -0,0 +1,160 @@
+package models
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Кому достаётся остаток от округления долей до минимальной единицы валюты.
+// Хранится в счёте, чтобы счёт всегда давал одни и те же проводки.
+type RoundingStrategy string
+
+const (
+	// Метод наибольших остатков (Гамильтона): недостающие копейки получают
+	// инвойсы с наибольшей отброшенной дробной частью. Стратегия по умолчанию.
+	RoundingLargestRemainder RoundingStrategy = "largest_remainder"
+	// Общая сумма долгов округляется вниз: дробную часть копейки теряют те,
+	// кто платил. Копейки внутри суммы - методом наибольших остатков.
+	RoundingPayerAbsorbs RoundingStrategy = "payer_absorbs"
+	// Округление по инвойсам владельца счёта - всегда не в его пользу,
+	// остальные инвойсы - методом наибольших остатков.
+	RoundingOwnerAbsorbs RoundingStrategy = "owner_absorbs"
+	// Недостающие копейки по очереди в порядке UserID должника.
+	RoundingRoundRobin RoundingStrategy = "round_robin"
+)
+
+var (
+	ErrUnknownRoundingStrategy = errors.New("unknown rounding strategy")
+)
+
+func (s RoundingStrategy) OrDefault() RoundingStrategy {
+	if s == "" {
+		return RoundingLargestRemainder
+	}
+
+	return s
+}
+
+func (s RoundingStrategy) Validate() error {
+	switch s.OrDefault() {
+	case RoundingLargestRemainder, RoundingPayerAbsorbs, RoundingOwnerAbsorbs, RoundingRoundRobin:
+		return nil
+	}
+
+	return errors.Wrapf(ErrUnknownRoundingStrategy, "%q", string(s))
+}
+
+// Округляет точные инвойсы. Сначала все суммы округляются вниз, затем
+// стратегия решает, какую сумму набрать и кому добавить недостающие копейки.
+func (s RoundingStrategy) round(exact []ratInvoice, currency Currency, ownerID UserID) ([]Invoice, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	invoices := make([]Invoice, len(exact))
+	remainders := make([]*big.Rat, len(exact))
+	for i, inv := range exact {
+		floor := ratFloor(inv.Exact.Rat, currency)
+
+		invoices[i] = inv.Invoice
+		invoices[i].Value = floor
+		remainders[i] = new(big.Rat).Sub(inv.Exact.Rat, floor.Rat())
+	}
+
+	// Стабильный порядок: по должнику, затем по кредитору.
+	stable := make([]int, len(exact))
+	for i := range stable {
+		stable[i] = i
+	}
+	sort.SliceStable(stable, func(a, b int) bool {
+		ia, ib := invoices[stable[a]], invoices[stable[b]]
+		if ia.UserTo != ib.UserTo {
+			return ia.UserTo < ib.UserTo
+		}
+		return ia.UserFrom < ib.UserFrom
+	})
+
+	withRemainder := func(include func(i int) bool) []int {
+		res := []int{}
+		for _, i := range stable {
+			if remainders[i].Sign() != 0 && include(i) {
+				res = append(res, i)
+			}
+		}
+		return res
+	}
+
+	largestFirst := func(order []int) []int {
+		sort.SliceStable(order, func(a, b int) bool {
+			return remainders[order[a]].Cmp(remainders[order[b]]) == 1
+		})
+		return order
+	}
+
+	all := func(int) bool { return true }
+	unit := decimal.New(1, -currency.Precision())
+
+	// Точная сумма по инвойсам include, округлённая вниз или вверх.
+	total := func(include func(i int) bool, ceil bool) decimal.Decimal {
+		sum := NewMoneyRat()
+		for i, inv := range exact {
+			if include(i) {
+				sum.Add(sum.Rat, inv.Exact.Rat)
+			}
+		}
+
+		floor := ratFloor(sum.Rat, currency)
+		if ceil && floor.Rat().Cmp(sum.Rat) != 0 {
+			return floor.Add(unit)
+		}
+		return floor.Decimal
+	}
+
+	// По умолчанию недостающее накидываем в сторону должников.
+	var target decimal.Decimal
+	var order []int
+	switch s.OrDefault() {
+	case RoundingLargestRemainder:
+		target = total(all, true)
+		order = largestFirst(withRemainder(all))
+	case RoundingRoundRobin:
+		target = total(all, true)
+		order = withRemainder(all)
+	case RoundingPayerAbsorbs:
+		target = total(all, false)
+		order = largestFirst(withRemainder(all))
+	case RoundingOwnerAbsorbs:
+		notOwner := func(i int) bool {
+			return invoices[i].UserFrom != ownerID && invoices[i].UserTo != ownerID
+		}
+
+		// Владелец должен - платит с округлением вверх, ему должны - получает
+		// с округлением вниз.
+		for i := range invoices {
+			if invoices[i].UserTo == ownerID && remainders[i].Sign() != 0 {
+				invoices[i].Value = Money{invoices[i].Value.Add(unit)}
+			}
+		}
+
+		target = total(notOwner, true)
+		for i := range invoices {
+			if !notOwner(i) {
+				target = target.Add(invoices[i].Value.Decimal)
+			}
+		}
+		order = largestFirst(withRemainder(notOwner))
+	}
+
+	return FixInvocesTotal(invoices, Money{target}, currency, order)
+}
+
+// Округление вниз до минимальной единицы валюты.
+func ratFloor(r *big.Rat, currency Currency) Money {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(currency.Precision())), nil)))
+	q := new(big.Int).Div(scaled.Num(), scaled.Denom())
+
+	return Money{decimal.NewFromBigInt(q, -currency.Precision())}
+}
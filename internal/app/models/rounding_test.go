@@ -0,0 +1,85 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// Счёт на 1.00: Боб (2) и Кэрол (3) делят позицию 1:2, платит Алиса (1).
+// Точно: Боб должен 0.(3), Кэрол - 0.(6), отброшено 0.00(3) и 0.00(6).
+func unevenBill(rounding models.RoundingStrategy, ownerID models.UserID) models.Bill {
+	return models.Bill{
+		OwnerID:  ownerID,
+		Rounding: rounding,
+		Items: []models.BillItem{{
+			Title:       "pie",
+			PricePerOne: money("1"),
+			Quantity:    decimal.NewFromInt(1),
+			Shares:      []models.BillShare{{UserID: 2, Share: 1}, {UserID: 3, Share: 2}},
+		}},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("1")}},
+	}
+}
+
+func owedBy(t *testing.T, bill models.Bill) map[models.UserID]string {
+	invoices, err := bill.ToInvoices()
+	require.NoError(t, err)
+
+	res := map[models.UserID]string{}
+	for _, inv := range invoices {
+		require.Equal(t, models.UserID(1), inv.UserFrom)
+		res[inv.UserTo] = inv.Value.StringFixed(2)
+	}
+
+	return res
+}
+
+func TestRoundingStrategies(t *testing.T) {
+	for _, tc := range []struct {
+		rounding models.RoundingStrategy
+		ownerID  models.UserID
+		want     map[models.UserID]string
+	}{
+		{rounding: "", want: map[models.UserID]string{2: "0.33", 3: "0.67"}},
+		{rounding: models.RoundingLargestRemainder, want: map[models.UserID]string{2: "0.33", 3: "0.67"}},
+		{rounding: models.RoundingRoundRobin, want: map[models.UserID]string{2: "0.34", 3: "0.66"}},
+		{rounding: models.RoundingPayerAbsorbs, want: map[models.UserID]string{2: "0.33", 3: "0.67"}},
+		// Боб - владелец, округляет себе вверх, Кэрол - по наибольшему остатку.
+		{rounding: models.RoundingOwnerAbsorbs, ownerID: 2, want: map[models.UserID]string{2: "0.34", 3: "0.67"}},
+	} {
+		t.Run(string(tc.rounding), func(t *testing.T) {
+			bill := unevenBill(tc.rounding, tc.ownerID)
+
+			// Одинаковый результат при каждом вызове.
+			for i := 0; i < 10; i++ {
+				require.Equal(t, tc.want, owedBy(t, bill))
+			}
+		})
+	}
+}
+
+func TestRoundingPayerAbsorbs(t *testing.T) {
+	require := require.New(t)
+
+	// 100 на троих: Алисе должны 66.(6).
+	bill := models.Bill{
+		Items: []models.BillItem{{
+			Title:       "pizza",
+			PricePerOne: money("100"),
+			Quantity:    decimal.NewFromInt(1),
+			Shares:      []models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 1}, {UserID: 3, Share: 1}},
+		}},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("100")}},
+	}
+
+	require.Equal(map[models.UserID]string{2: "33.34", 3: "33.33"}, owedBy(t, bill))
+
+	bill.Rounding = models.RoundingPayerAbsorbs
+	require.Equal(map[models.UserID]string{2: "33.33", 3: "33.33"}, owedBy(t, bill))
+
+	bill.Rounding = "random"
+	require.ErrorIs(bill.Validate(), models.ErrUnknownRoundingStrategy)
+}
@@ -0,0 +1,194 @@
+package models
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// До такого числа ненулевых балансов ищем точный минимум переводов,
+	// перебор идёт по всем подмножествам: O(2^n * n).
+	ExactSettlementLimit = 16
+)
+
+// Перевод для погашения долгов. В терминах Invoice: UserFrom - кому должны,
+// UserTo - кто должен и переводит деньги.
+//
+// Минимальное число переводов для n участников - это n минус максимальное число
+// непересекающихся подмножеств с нулевой суммой: внутри подмножества из k
+// человек хватает k-1 перевода. Балансы разных валют не смешиваются, план
+// строится для одной валюты.
+func SettlementPlan(balances map[UserID]Money, currency Currency) ([]Invoice, error) {
+	type userCents struct {
+		UserID UserID
+		Cents  int64
+	}
+
+	entries := make([]userCents, 0, len(balances))
+	var total int64
+	for userID, m := range balances {
+		if err := m.Validate(currency); err != nil {
+			return nil, errors.Wrapf(err, "balance of %s", userID)
+		}
+
+		cents := m.Shift(currency.Precision()).IntPart()
+		if cents == 0 {
+			continue
+		}
+
+		entries = append(entries, userCents{UserID: userID, Cents: cents})
+		total += cents
+	}
+
+	if total != 0 {
+		return nil, ErrBalanceNotZero
+	}
+
+	// Детерминированный порядок, чтобы план не зависел от порядка обхода map.
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].UserID < entries[j].UserID
+	})
+
+	var groups [][]int
+	if len(entries) <= ExactSettlementLimit {
+		values := make([]int64, len(entries))
+		for i, e := range entries {
+			values[i] = e.Cents
+		}
+
+		groups = zeroSumGroups(values)
+	} else {
+		groups = greedyZeroSumGroups(func(i int) int64 { return entries[i].Cents }, len(entries))
+	}
+
+	invoices := []Invoice{}
+	for _, group := range groups {
+		groupBalances := make(map[UserID]MoneyRat, len(group))
+		for _, i := range group {
+			groupBalances[entries[i].UserID] = MoneyRat{decimal.New(entries[i].Cents, -currency.Precision()).Rat()}
+		}
+
+		groupInvoices, err := InvoicesFromBalances(groupBalances, currency)
+		if err != nil {
+			return nil, errors.Wrap(err, "fail settle group")
+		}
+
+		invoices = append(invoices, groupInvoices...)
+	}
+
+	return invoices, nil
+}
+
+// Разбиение на максимальное число подмножеств с нулевой суммой.
+// dp[mask] - максимум нулевых групп, на которые можно разбить маску, если
+// добавлять элементы по одному: каждый раз, когда сумма префикса нулевая,
+// закрывается очередная группа.
+func zeroSumGroups(values []int64) [][]int {
+	n := len(values)
+	if n == 0 {
+		return nil
+	}
+
+	full := 1<<n - 1
+	sums := make([]int64, full+1)
+	dp := make([]int, full+1)
+	for mask := 1; mask <= full; mask++ {
+		low := bits.TrailingZeros(uint(mask))
+		sums[mask] = sums[mask&(mask-1)] + values[low]
+
+		best := 0
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			i := bits.TrailingZeros(uint(rest))
+			if v := dp[mask&^(1<<i)]; v > best {
+				best = v
+			}
+		}
+
+		if sums[mask] == 0 {
+			best++
+		}
+		dp[mask] = best
+	}
+
+	// Восстанавливаем порядок добавления элементов, снимая их с конца.
+	order := make([]int, n)
+	mask := full
+	for pos := n - 1; pos >= 0; pos-- {
+		bonus := 0
+		if sums[mask] == 0 {
+			bonus = 1
+		}
+
+		for rest := mask; rest != 0; rest &= rest - 1 {
+			i := bits.TrailingZeros(uint(rest))
+			if dp[mask&^(1<<i)]+bonus == dp[mask] {
+				order[pos] = i
+				mask &^= 1 << i
+				break
+			}
+		}
+	}
+
+	var groups [][]int
+	var group []int
+	var sum int64
+	for _, i := range order {
+		group = append(group, i)
+		sum += values[i]
+
+		if sum == 0 {
+			groups = append(groups, group)
+			group = nil
+		}
+	}
+
+	return groups
+}
+
+// Для больших компаний: сначала пары с равными по модулю балансами
+// (один перевод закрывает двоих), остальное - одной группой.
+func greedyZeroSumGroups(value func(int) int64, n int) [][]int {
+	byAmount := map[int64][]int{}
+	for i := 0; i < n; i++ {
+		byAmount[value(i)] = append(byAmount[value(i)], i)
+	}
+
+	paired := make([]bool, n)
+	var groups [][]int
+	for i := 0; i < n; i++ {
+		v := value(i)
+		if paired[i] || v <= 0 {
+			continue
+		}
+
+		candidates := byAmount[-v]
+		for len(candidates) > 0 && paired[candidates[0]] {
+			candidates = candidates[1:]
+		}
+
+		if len(candidates) > 0 {
+			j := candidates[0]
+			paired[i], paired[j] = true, true
+			groups = append(groups, []int{i, j})
+			candidates = candidates[1:]
+		}
+
+		byAmount[-v] = candidates
+	}
+
+	var rest []int
+	for i := 0; i < n; i++ {
+		if !paired[i] {
+			rest = append(rest, i)
+		}
+	}
+
+	if len(rest) > 0 {
+		groups = append(groups, rest)
+	}
+
+	return groups
+}
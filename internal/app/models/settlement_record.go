@@ -0,0 +1,52 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNoDebt                = errors.New("payer has no debt to payee")
+	ErrSettlementExceedsDebt = errors.New("settlement amount exceeds debt")
+	ErrNonPositiveAmount     = errors.New("amount must be positive")
+	ErrSelfSettlement        = errors.New("payer and payee must differ")
+)
+
+// Погашение долга вне приложения: PayerID вернул PayeeID сумму Amount.
+// GroupID - группа, в которой гасится долг, 0 - личное погашение.
+type Settlement struct {
+	ID         SettlementID
+	RecordedBy UserID
+	PayerID    UserID
+	PayeeID    UserID
+	Amount     Money
+	Currency   Currency
+	GroupID    GroupID
+	CreatedAt  time.Time
+}
+
+type SettlementID int64
+
+func (sid SettlementID) String() string {
+	return fmt.Sprintf("SettlementID(%d)", sid)
+}
+
+// Проводка, обратная долгу: деньги идут от должника к тому, кому он должен.
+func (s *Settlement) ToInvoice() Invoice {
+	return Invoice{
+		UserFrom: s.PayerID,
+		UserTo:   s.PayeeID,
+		Value:    s.Amount,
+		Currency: s.Currency,
+	}
+}
+
+// Фильтр истории погашений. Нулевые значения - без ограничений.
+type SettlementListFilter struct {
+	CounterpartyID UserID
+	// Курсор: только погашения с ID меньше, чем этот.
+	BeforeID SettlementID
+	Limit    uint64
+}
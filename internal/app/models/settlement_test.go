@@ -0,0 +1,77 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func money(v string) models.Money {
+	return models.Money{Decimal: decimal.RequireFromString(v)}
+}
+
+// Проверяет, что план ровно обнуляет все балансы.
+func requireSettles(t *testing.T, balances map[models.UserID]models.Money, plan []models.Invoice) {
+	left := map[models.UserID]decimal.Decimal{}
+	for userID, m := range balances {
+		left[userID] = m.Decimal
+	}
+
+	for _, inv := range plan {
+		require.True(t, inv.Value.IsPositive(), "transfer must be positive: %v", inv)
+		left[inv.UserFrom] = left[inv.UserFrom].Sub(inv.Value.Decimal)
+		left[inv.UserTo] = left[inv.UserTo].Add(inv.Value.Decimal)
+	}
+
+	for userID, v := range left {
+		require.True(t, v.IsZero(), "%s isn't settled: %s", userID, v)
+	}
+}
+
+func TestSettlementPlanExact(t *testing.T) {
+	require := require.New(t)
+
+	// Жадный алгоритм даёт 4 перевода, а можно за 3: {1,2} и {3,4,5}.
+	balances := map[models.UserID]models.Money{
+		1: money("50"),
+		2: money("-50"),
+		3: money("60"),
+		4: money("-30"),
+		5: money("-30"),
+	}
+
+	plan, err := models.SettlementPlan(balances, models.DefaultCurrency)
+	require.NoError(err)
+	require.Len(plan, 3)
+	requireSettles(t, balances, plan)
+}
+
+func TestSettlementPlanZeroAndEmpty(t *testing.T) {
+	require := require.New(t)
+
+	plan, err := models.SettlementPlan(map[models.UserID]models.Money{1: money("0")}, models.DefaultCurrency)
+	require.NoError(err)
+	require.Empty(plan)
+
+	_, err = models.SettlementPlan(map[models.UserID]models.Money{1: money("10"), 2: money("-5")}, models.DefaultCurrency)
+	require.ErrorIs(err, models.ErrBalanceNotZero)
+}
+
+func TestSettlementPlanGreedyFallback(t *testing.T) {
+	require := require.New(t)
+
+	balances := map[models.UserID]models.Money{}
+	n := models.ExactSettlementLimit + 4
+	for i := 1; i <= n/2; i++ {
+		balances[models.UserID(i)] = money(decimal.NewFromInt(int64(i)).String())
+		balances[models.UserID(n+1-i)] = money(decimal.NewFromInt(int64(-i)).String())
+	}
+
+	plan, err := models.SettlementPlan(balances, models.DefaultCurrency)
+	require.NoError(err)
+	// Все балансы разбиваются на пары.
+	require.Len(plan, n/2)
+	requireSettles(t, balances, plan)
+}
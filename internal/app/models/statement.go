@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidStatementFilter = errors.New("invalid statement filter")
+)
+
+// Чем порождена проводка в выписке.
+type StatementSource string
+
+const (
+	StatementSourceBill       StatementSource = "split_the_bill"
+	StatementSourceSettlement StatementSource = "settlement"
+)
+
+// Строка выписки: одна проводка глазами пользователя.
+type StatementEntry struct {
+	EntryID   int64
+	CreatedAt time.Time
+	Source    StatementSource
+	// Заполнен один из двух, по Source.
+	BillID       BillID
+	SettlementID SettlementID
+	GroupID      GroupID
+
+	CounterpartyID UserID
+	Currency       Currency
+	// Положительная - контрагент стал должен пользователю больше.
+	Amount Money
+	// Баланс в валюте Currency после этой проводки, с учётом всех
+	// более ранних проводок под те же CounterpartyID и GroupID фильтра.
+	Balance Money
+}
+
+type StatementFilter struct {
+	CounterpartyID UserID
+	GroupID        GroupID
+	// Полуинтервал [From, To). Проводки до From входят в начальный баланс.
+	From time.Time
+	To   time.Time
+	// Курсор: только проводки после этой, в порядке выписки.
+	AfterEntryID int64
+	Limit        uint64
+}
+
+func (f *StatementFilter) Validate(userID UserID) error {
+	if f.CounterpartyID == userID {
+		return errors.Wrapf(ErrInvalidStatementFilter, "counterparty %s is the user", userID)
+	}
+
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return errors.Wrapf(ErrInvalidStatementFilter, "empty range [%s, %s)", f.From, f.To)
+	}
+
+	return nil
+}
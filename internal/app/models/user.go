@@ -1,6 +1,21 @@
 package models
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidUser        = errors.New("invalid user")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrNotPlaceholder     = errors.New("user is not a placeholder")
+	ErrPlaceholderClaimed = errors.New("placeholder is already claimed")
+	ErrUserExists         = errors.New("user already exists")
+	ErrUserDeactivated    = errors.New("user is deactivated")
+)
 
 type UserID int64
 
@@ -11,4 +26,76 @@ func (uid UserID) String() string {
 type User struct {
 	ID    UserID
 	Title string
+	// Заглушка для того, кого ещё нет в приложении. Её можно указывать
+	// в счетах, а потом настоящий пользователь забирает её по приглашению.
+	Placeholder bool
+	// Кто создал заглушку.
+	CreatedBy UserID
+	// Настоящий пользователь, забравший заглушку. 0 - ещё не забрана.
+	ClaimedBy UserID
+	// В кого слит этот дубль. 0 - не слит.
+	MergedInto UserID
+	// Учётная запись во внешней системе, если пользователь пришёл оттуда.
+	External  ExternalIdentity
+	CreatedAt time.Time
+	// Нулевое время - пользователь активен.
+	DeactivatedAt time.Time
+}
+
+// Учётная запись во внешней системе: провайдер входа, мессенджер и т.п.
+// Subject уникален в пределах Provider.
+type ExternalIdentity struct {
+	Provider string
+	Subject  string
+}
+
+func (e ExternalIdentity) IsZero() bool {
+	return e.Provider == "" && e.Subject == ""
+}
+
+func (e ExternalIdentity) String() string {
+	return e.Provider + ":" + e.Subject
+}
+
+// Деактивированных и слитых пользователей нельзя менять и не видно в поиске.
+func (u *User) Active() bool {
+	return u.DeactivatedAt.IsZero() && u.MergedInto == 0
+}
+
+func (u *User) Validate() error {
+	if strings.TrimSpace(u.Title) == "" {
+		return errors.Wrap(ErrInvalidUser, "title is empty")
+	}
+
+	if !u.External.IsZero() && (u.External.Provider == "" || u.External.Subject == "") {
+		return errors.Wrapf(ErrInvalidUser, "incomplete external identity %q", u.External)
+	}
+
+	if u.Placeholder && !u.External.IsZero() {
+		return errors.Wrap(ErrInvalidUser, "placeholder can't have external identity")
+	}
+
+	return nil
+}
+
+// Проверяет, что пользователя можно менять.
+func (u *User) CheckActive() error {
+	if err := u.CheckNotMerged(); err != nil {
+		return err
+	}
+
+	if !u.DeactivatedAt.IsZero() {
+		return errors.Wrapf(ErrUserDeactivated, "%s", u.ID)
+	}
+
+	return nil
+}
+
+// Слитый дубль больше нигде не участвует, всё записывается на MergedInto.
+func (u *User) CheckNotMerged() error {
+	if u.MergedInto != 0 {
+		return errors.Wrapf(ErrUserMerged, "%s into %s", u.ID, u.MergedInto)
+	}
+
+	return nil
 }
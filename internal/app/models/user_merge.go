@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSelfMerge  = errors.New("can't merge user into itself")
+	ErrUserMerged = errors.New("user is already merged into another user")
+)
+
+// Что перенесено с одного пользователя на другого при слиянии или когда
+// заглушку забирает настоящий пользователь.
+type UserReassignment struct {
+	EntriesMoved int64
+	// Проводки между двумя пользователями: после переноса это долг самому себе.
+	EntriesDropped     int64
+	SettlementsMoved   int64
+	SettlementsDropped int64
+	BillsRewritten     int64
+	RevisionsRewritten int64
+	MembershipsMoved   int64
+	// Группы, где были оба: остаётся одно членство с более сильной ролью.
+	MembershipsMerged int64
+}
+
+// Слияние дубля SourceID в TargetID. После слияния SourceID остаётся
+// в таблице пользователей, но ни на что не ссылается.
+type UserMerge struct {
+	ID       int64
+	TargetID UserID
+	SourceID UserID
+	UserReassignment
+	CreatedAt time.Time
+	// Ничего не сохранено, только посчитано.
+	DryRun bool
+}
+
+func (m *UserMerge) Validate() error {
+	if m.TargetID == m.SourceID {
+		return errors.Wrapf(ErrSelfMerge, "%s", m.TargetID)
+	}
+
+	return nil
+}
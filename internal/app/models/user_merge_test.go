@@ -0,0 +1,18 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserMergeValidate(t *testing.T) {
+	require := require.New(t)
+
+	merge := models.UserMerge{TargetID: 1, SourceID: 2}
+	require.NoError(merge.Validate())
+
+	merge.SourceID = merge.TargetID
+	require.ErrorIs(merge.Validate(), models.ErrSelfMerge)
+}
@@ -0,0 +1,43 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserValidate(t *testing.T) {
+	require := require.New(t)
+
+	user := models.User{Title: "alice"}
+	require.NoError(user.Validate())
+
+	user.External = models.ExternalIdentity{Provider: "telegram", Subject: "42"}
+	require.NoError(user.Validate())
+
+	user.External.Subject = ""
+	require.ErrorIs(user.Validate(), models.ErrInvalidUser)
+
+	user = models.User{Title: "bob", Placeholder: true, External: models.ExternalIdentity{Provider: "telegram", Subject: "43"}}
+	require.ErrorIs(user.Validate(), models.ErrInvalidUser)
+}
+
+func TestUserCheckActive(t *testing.T) {
+	require := require.New(t)
+
+	user := models.User{ID: 1, Title: "alice"}
+	require.True(user.Active())
+	require.NoError(user.CheckActive())
+
+	user.DeactivatedAt = time.Date(2023, 4, 6, 0, 0, 0, 0, time.UTC)
+	require.False(user.Active())
+	require.ErrorIs(user.CheckActive(), models.ErrUserDeactivated)
+	require.NoError(user.CheckNotMerged())
+
+	user = models.User{ID: 2, Title: "bob", MergedInto: 1}
+	require.False(user.Active())
+	require.ErrorIs(user.CheckActive(), models.ErrUserMerged)
+	require.ErrorIs(user.CheckNotMerged(), models.ErrUserMerged)
+}
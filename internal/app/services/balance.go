@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
@@ -9,7 +10,17 @@ import (
 
 type BalanceStorage interface {
 	// GetInvoices(context.Context, models.UserID) ([]models.Invoice, error)
-	GetBalanceForUser(context.Context, models.UserID) (models.Account, error)
+	GetUserAccount(context.Context, models.UserID) (map[models.Currency]models.Account, error)
+	GetUserBalances(context.Context, models.UserID) (models.BalancesByCurrency, error)
+	GetExchangeRates(context.Context, []models.Currency, time.Time) (models.ExchangeRates, error)
+	GetStatement(context.Context, models.UserID, models.StatementFilter) ([]models.StatementEntry, error)
+}
+
+// Пересчёт балансов в одну валюту по курсам на дату.
+type BalanceConversion struct {
+	Currency models.Currency
+	// Берутся последние курсы не позже этой даты. Нулевая - сегодня.
+	Date time.Time
 }
 
 type BalanceService struct {
@@ -28,8 +39,8 @@ func (s *BalanceService) log(ctx context.Context) logger.Logger {
 	return logger.FromCtxOrDefault(ctx, s.logger)
 }
 
-func (s *BalanceService) GetBalance(ctx context.Context, userID models.UserID) (models.Account, error) {
-	acc, err := s.storage.GetBalanceForUser(ctx, userID)
+func (s *BalanceService) GetBalance(ctx context.Context, userID models.UserID) (map[models.Currency]models.Account, error) {
+	acc, err := s.storage.GetUserAccount(ctx, userID)
 	if err != nil {
 		s.log(ctx).Error().Err(err).
 			Int64("user_id", int64(userID)).
@@ -38,3 +49,63 @@ func (s *BalanceService) GetBalance(ctx context.Context, userID models.UserID) (
 
 	return acc, err
 }
+
+// Балансы с контрагентами по валютам. Положительный - контрагент должен userID.
+func (s *BalanceService) GetCounterpartyBalances(ctx context.Context, userID models.UserID) (models.BalancesByCurrency, error) {
+	balances, err := s.storage.GetUserBalances(ctx, userID)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("user_id", int64(userID)).
+			Msg("fail to get user's balances from storage")
+	}
+
+	return balances, err
+}
+
+// Балансы с контрагентами, пересчитанные в одну валюту.
+func (s *BalanceService) GetConvertedBalances(ctx context.Context, userID models.UserID, conversion BalanceConversion) (models.ConvertedBalances, error) {
+	date := conversion.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	balances, err := s.storage.GetUserBalances(ctx, userID)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("user_id", int64(userID)).
+			Msg("fail to get user's balances from storage")
+		return models.ConvertedBalances{}, err
+	}
+
+	currencies := make([]models.Currency, 0, len(balances)+1)
+	currencies = append(currencies, conversion.Currency.OrDefault())
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+
+	rates, err := s.storage.GetExchangeRates(ctx, currencies, date)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Time("date", date).
+			Msg("fail to get exchange rates from storage")
+		return models.ConvertedBalances{}, err
+	}
+
+	return models.ConvertBalances(balances, conversion.Currency, date, rates)
+}
+
+// Выписка по проводкам пользователя с нарастающим балансом.
+func (s *BalanceService) GetStatement(ctx context.Context, userID models.UserID, filter models.StatementFilter) ([]models.StatementEntry, error) {
+	if err := filter.Validate(userID); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.storage.GetStatement(ctx, userID, filter)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("user_id", int64(userID)).
+			Msg("fail to get user's statement from storage")
+	}
+
+	return entries, err
+}
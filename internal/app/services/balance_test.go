@@ -0,0 +1,102 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+type memBalanceStorage struct {
+	balances models.BalancesByCurrency
+	rates    models.ExchangeRates
+}
+
+func (m *memBalanceStorage) GetUserAccount(ctx context.Context, userID models.UserID) (map[models.Currency]models.Account, error) {
+	panic("not used")
+}
+
+func (m *memBalanceStorage) GetUserBalances(ctx context.Context, userID models.UserID) (models.BalancesByCurrency, error) {
+	return m.balances, nil
+}
+
+// Курсы не позже даты, последний на пару.
+func (m *memBalanceStorage) GetExchangeRates(ctx context.Context, currencies []models.Currency, date time.Time) (models.ExchangeRates, error) {
+	latest := map[[2]models.Currency]models.ExchangeRate{}
+	for _, r := range m.rates {
+		key := [2]models.Currency{r.Base, r.Quote}
+		if r.Date.After(date) || latest[key].Date.After(r.Date) {
+			continue
+		}
+		latest[key] = r
+	}
+
+	res := models.ExchangeRates{}
+	for _, r := range latest {
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+func (m *memBalanceStorage) GetStatement(ctx context.Context, userID models.UserID, filter models.StatementFilter) ([]models.StatementEntry, error) {
+	return nil, nil
+}
+
+func TestGetStatementFilter(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	log := zerolog.Nop()
+	s := services.NewBalanceService(&memBalanceStorage{}, &log)
+
+	day := func(d int) time.Time { return time.Date(2023, 4, d, 0, 0, 0, 0, time.UTC) }
+
+	_, err := s.GetStatement(ctx, 1, models.StatementFilter{From: day(2), To: day(2)})
+	require.ErrorIs(err, models.ErrInvalidStatementFilter)
+
+	_, err = s.GetStatement(ctx, 1, models.StatementFilter{CounterpartyID: 1})
+	require.ErrorIs(err, models.ErrInvalidStatementFilter)
+
+	_, err = s.GetStatement(ctx, 1, models.StatementFilter{CounterpartyID: 2, From: day(1), To: day(2)})
+	require.NoError(err)
+}
+
+func TestGetConvertedBalances(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	day := func(d int) time.Time { return time.Date(2023, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	balances := models.BalancesByCurrency{}
+	balances.Add("USD", 2, *money("10"))
+	balances.Add(models.DefaultCurrency, 2, *money("-100"))
+
+	storage := &memBalanceStorage{
+		balances: balances,
+		rates: models.ExchangeRates{
+			{Base: "USD", Quote: "RUB", Date: day(1), Rate: decimal.NewFromInt(70)},
+			{Base: "USD", Quote: "RUB", Date: day(3), Rate: decimal.NewFromInt(80)},
+		},
+	}
+	log := zerolog.Nop()
+	s := services.NewBalanceService(storage, &log)
+
+	converted, err := s.GetConvertedBalances(ctx, 1, services.BalanceConversion{Currency: models.DefaultCurrency, Date: day(2)})
+	require.NoError(err)
+	require.True(converted.Balances[2].Equal(decimal.NewFromInt(600)), "got %s", converted.Balances[2])
+	require.Len(converted.Rates, 1)
+	require.Equal(day(1), converted.Rates[0].Date)
+
+	converted, err = s.GetConvertedBalances(ctx, 1, services.BalanceConversion{Currency: models.DefaultCurrency, Date: day(5)})
+	require.NoError(err)
+	require.True(converted.Balances[2].Equal(decimal.NewFromInt(700)), "got %s", converted.Balances[2])
+
+	_, err = s.GetConvertedBalances(ctx, 1, services.BalanceConversion{Currency: "USD", Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+	require.ErrorIs(err, models.ErrExchangeRateNotFound)
+}
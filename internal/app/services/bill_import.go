@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+)
+
+type BillImportStorage interface {
+	GetImportedBillIDs(context.Context, models.UserID, []string) (map[string]models.BillID, error)
+	SaveImportedBills(context.Context, models.UserID, []models.ImportedBill) ([]models.BillID, int, error)
+}
+
+// Загрузка счетов из внешних файлов, см. пакет importer.
+type BillImportService struct {
+	storage BillImportStorage
+	logger  logger.Logger
+}
+
+func NewBillImportService(storage BillImportStorage, log logger.Logger) *BillImportService {
+	return &BillImportService{
+		storage: storage,
+		logger:  log,
+	}
+}
+
+func (s *BillImportService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// Сохраняет счета от имени ownerID одной транзакцией. Строки, загруженные
+// раньше тем же ownerID, пропускаются. Если хоть один счёт невалиден, не
+// сохраняется ничего.
+func (s *BillImportService) ImportBills(ctx context.Context, ownerID models.UserID, bills []models.ImportedBill, dryRun bool) (models.BillImportReport, error) {
+	for i := range bills {
+		if err := bills[i].Validate(); err != nil {
+			return models.BillImportReport{}, err
+		}
+	}
+
+	if dryRun {
+		hashes := make([]string, 0, len(bills))
+		for _, ib := range bills {
+			hashes = append(hashes, ib.SourceHash)
+		}
+
+		existing, err := s.storage.GetImportedBillIDs(ctx, ownerID, hashes)
+		if err != nil {
+			return models.BillImportReport{}, err
+		}
+
+		report := models.BillImportReport{DryRun: true}
+		for _, ib := range bills {
+			if _, ok := existing[ib.SourceHash]; ok {
+				report.Skipped++
+			} else {
+				report.Pending++
+			}
+		}
+
+		return report, nil
+	}
+
+	imported, skipped, err := s.storage.SaveImportedBills(ctx, ownerID, bills)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("owner_id", int64(ownerID)).
+			Int("bills_count", len(bills)).
+			Msg("fail to save imported bills")
+		return models.BillImportReport{}, err
+	}
+
+	s.log(ctx).Info().
+		Int64("owner_id", int64(ownerID)).
+		Int("imported", len(imported)).
+		Int("skipped", skipped).
+		Msg("bills imported")
+
+	return models.BillImportReport{Imported: imported, Skipped: skipped}, nil
+}
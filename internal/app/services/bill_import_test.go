@@ -0,0 +1,105 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+type memImportKey struct {
+	ownerID models.UserID
+	hash    string
+}
+
+type memBillImportStorage struct {
+	imported map[memImportKey]models.BillID
+	nextID   models.BillID
+}
+
+func (m *memBillImportStorage) GetImportedBillIDs(ctx context.Context, ownerID models.UserID, hashes []string) (map[string]models.BillID, error) {
+	res := map[string]models.BillID{}
+	for _, hash := range hashes {
+		if billID, ok := m.imported[memImportKey{ownerID, hash}]; ok {
+			res[hash] = billID
+		}
+	}
+
+	return res, nil
+}
+
+func (m *memBillImportStorage) SaveImportedBills(ctx context.Context, ownerID models.UserID, bills []models.ImportedBill) ([]models.BillID, int, error) {
+	var imported []models.BillID
+	skipped := 0
+	for _, ib := range bills {
+		if _, ok := m.imported[memImportKey{ownerID, ib.SourceHash}]; ok {
+			skipped++
+			continue
+		}
+
+		m.nextID++
+		m.imported[memImportKey{ownerID, ib.SourceHash}] = m.nextID
+		imported = append(imported, m.nextID)
+	}
+
+	return imported, skipped, nil
+}
+
+func importedBill(line int, hash string) models.ImportedBill {
+	return models.ImportedBill{
+		Line:       line,
+		SourceHash: hash,
+		Bill: models.Bill{
+			Items: []models.BillItem{{
+				Title:       "Пицца",
+				PricePerOne: *money("10"),
+				Quantity:    decimal.NewFromInt(1),
+				Shares:      []models.BillShare{{UserID: 1, Share: 1}, {UserID: 2, Share: 1}},
+			}},
+			Payments: []models.BillPayment{{UserID: 1, Amount: *money("10")}},
+		},
+	}
+}
+
+func TestImportBills(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	log := zerolog.Nop()
+	s := services.NewBillImportService(&memBillImportStorage{imported: map[memImportKey]models.BillID{}}, &log)
+
+	first := []models.ImportedBill{importedBill(2, "a"), importedBill(3, "b")}
+	report, err := s.ImportBills(ctx, 1, first, false)
+	require.NoError(err)
+	require.Equal([]models.BillID{1, 2}, report.Imported)
+
+	// Повторный запуск с новой строкой: старые пропускаются.
+	second := append(first, importedBill(4, "c"))
+	report, err = s.ImportBills(ctx, 1, second, true)
+	require.NoError(err)
+	require.Equal(models.BillImportReport{DryRun: true, Skipped: 2, Pending: 1}, report)
+
+	report, err = s.ImportBills(ctx, 1, second, false)
+	require.NoError(err)
+	require.Equal([]models.BillID{3}, report.Imported)
+	require.Equal(2, report.Skipped)
+
+	// Тот же файл у другого владельца - его собственные счета.
+	report, err = s.ImportBills(ctx, 2, first, false)
+	require.NoError(err)
+	require.Equal([]models.BillID{4, 5}, report.Imported)
+	require.Zero(report.Skipped)
+
+	invalid := importedBill(5, "d")
+	invalid.Bill.Payments = nil
+	_, err = s.ImportBills(ctx, 1, []models.ImportedBill{importedBill(4, "e"), invalid}, false)
+	require.ErrorIs(err, models.ErrDiscrepancy)
+	require.ErrorContains(err, "line 5")
+
+	_, err = s.ImportBills(ctx, 1, []models.ImportedBill{importedBill(6, "")}, false)
+	require.ErrorIs(err, models.ErrInvalidBillImport)
+}
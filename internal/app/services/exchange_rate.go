@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+type ExchangeRateStorage interface {
+	SaveExchangeRates(context.Context, []models.ExchangeRate) error
+	GetExchangeRates(context.Context, []models.Currency, time.Time) (models.ExchangeRates, error)
+}
+
+type ExchangeRateService struct {
+	storage ExchangeRateStorage
+	logger  logger.Logger
+}
+
+func NewExchangeRateService(storage ExchangeRateStorage, log logger.Logger) *ExchangeRateService {
+	return &ExchangeRateService{
+		storage: storage,
+		logger:  log,
+	}
+}
+
+func (s *ExchangeRateService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// Загружает курсы. Если хоть один курс невалиден, не сохраняется ничего.
+func (s *ExchangeRateService) ImportRates(ctx context.Context, rates []models.ExchangeRate) error {
+	for i := range rates {
+		if err := rates[i].Validate(); err != nil {
+			return errors.Wrapf(err, "rate at index %d is invalid", i)
+		}
+	}
+
+	if err := s.storage.SaveExchangeRates(ctx, rates); err != nil {
+		s.log(ctx).Error().Err(err).
+			Int("rates_count", len(rates)).
+			Msg("fail to save exchange rates")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/exporter"
+)
+
+// Сколько счетов или проводок читать из базы за раз.
+const exportBatchSize = 500
+
+type ExportStorage interface {
+	ListUserBills(context.Context, models.UserID, models.BillListFilter) ([]models.Bill, error)
+	GetStatement(context.Context, models.UserID, models.StatementFilter) ([]models.StatementEntry, error)
+}
+
+// Отчёты для выгрузки пользователю: CLI и ExportService.
+type ExportService struct {
+	storage ExportStorage
+	logger  logger.Logger
+}
+
+func NewExportService(storage ExportStorage, log logger.Logger) *ExportService {
+	return &ExportService{
+		storage: storage,
+		logger:  log,
+	}
+}
+
+func (s *ExportService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// Пишет отчёт kind (exporter.ReportLedger или exporter.ReportBills) за период
+// в w страница за страницей, по мере чтения из базы, и закрывает w. Формат
+// файла выбирает вызывающий, см. exporter.NewWriter.
+func (s *ExportService) Export(ctx context.Context, kind string, period exporter.Period, w exporter.Writer) error {
+	kind, err := exporter.NormalizeReport(kind)
+	if err != nil {
+		return err
+	}
+
+	if err := period.Validate(); err != nil {
+		return err
+	}
+
+	switch kind {
+	case exporter.ReportLedger:
+		err = s.writeLedger(ctx, period, w)
+	case exporter.ReportBills:
+		err = s.writeBills(ctx, period, w)
+	}
+
+	if err == nil {
+		err = w.Close()
+	}
+
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("user_id", int64(period.UserID)).
+			Str("report", kind).
+			Msg("fail to export report")
+		return err
+	}
+
+	return nil
+}
+
+func (s *ExportService) writeLedger(ctx context.Context, period exporter.Period, w exporter.Writer) error {
+	if err := w.WriteHeader(exporter.LedgerHeader(period)); err != nil {
+		return err
+	}
+
+	filter := models.StatementFilter{
+		From:  period.From,
+		To:    period.To,
+		Limit: exportBatchSize,
+	}
+
+	for {
+		entries, err := s.storage.GetStatement(ctx, period.UserID, filter)
+		if err != nil {
+			return err
+		}
+
+		if err := w.WritePage(exporter.LedgerPage(entries)); err != nil {
+			return err
+		}
+
+		if uint64(len(entries)) < filter.Limit {
+			return nil
+		}
+
+		filter.AfterEntryID = entries[len(entries)-1].EntryID
+	}
+}
+
+// Счета от старых к новым.
+func (s *ExportService) writeBills(ctx context.Context, period exporter.Period, w exporter.Writer) error {
+	if err := w.WriteHeader(exporter.BillsHeader(period)); err != nil {
+		return err
+	}
+
+	filter := models.BillListFilter{
+		CreatedFrom: period.From,
+		CreatedTo:   period.To,
+		OldestFirst: true,
+		Limit:       exportBatchSize,
+	}
+
+	for {
+		bills, err := s.storage.ListUserBills(ctx, period.UserID, filter)
+		if err != nil {
+			return err
+		}
+
+		page, err := exporter.BillsPage(bills)
+		if err != nil {
+			return err
+		}
+
+		if err := w.WritePage(page); err != nil {
+			return err
+		}
+
+		if uint64(len(bills)) < filter.Limit {
+			return nil
+		}
+
+		filter.AfterID = bills[len(bills)-1].ID
+	}
+}
@@ -0,0 +1,112 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/exporter"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// Счета и проводки с ID от 1 до n, выдаются страницами как в pgsql.
+// Каждое чтение пишется в events.
+type memExportStorage struct {
+	n      int64
+	events *[]string
+}
+
+func (m *memExportStorage) ListUserBills(ctx context.Context, userID models.UserID, filter models.BillListFilter) ([]models.Bill, error) {
+	*m.events = append(*m.events, "read")
+
+	var res []models.Bill
+	for id := int64(filter.AfterID) + 1; filter.OldestFirst && id <= m.n && uint64(len(res)) < filter.Limit; id++ {
+		res = append(res, models.Bill{
+			ID: models.BillID(id),
+			Items: []models.BillItem{{
+				Title:       "Пицца",
+				PricePerOne: *money("10"),
+				Quantity:    decimal.NewFromInt(1),
+				Shares:      []models.BillShare{{UserID: userID, Share: 1}, {UserID: 2, Share: 1}},
+			}},
+			Payments: []models.BillPayment{{UserID: userID, Amount: *money("10")}},
+		})
+	}
+
+	return res, nil
+}
+
+func (m *memExportStorage) GetStatement(ctx context.Context, userID models.UserID, filter models.StatementFilter) ([]models.StatementEntry, error) {
+	*m.events = append(*m.events, "read")
+
+	var res []models.StatementEntry
+	for id := filter.AfterEntryID + 1; id <= m.n && uint64(len(res)) < filter.Limit; id++ {
+		res = append(res, models.StatementEntry{EntryID: id, Currency: models.DefaultCurrency, Amount: *money("1"), Balance: *money("1")})
+	}
+
+	return res, nil
+}
+
+// Запоминает отчёт и пишет каждую страницу в events.
+type memExportWriter struct {
+	header exporter.ReportHeader
+	rows   [][]string
+	closed bool
+	events *[]string
+}
+
+func (w *memExportWriter) WriteHeader(header exporter.ReportHeader) error {
+	w.header = header
+	return nil
+}
+
+func (w *memExportWriter) WritePage(page exporter.ReportPage) error {
+	*w.events = append(*w.events, "write")
+	w.rows = append(w.rows, page.Rows...)
+	return nil
+}
+
+func (w *memExportWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var events []string
+	log := zerolog.Nop()
+	s := services.NewExportService(&memExportStorage{n: 1201, events: &events}, &log)
+	period := exporter.Period{UserID: 1}
+
+	w := &memExportWriter{events: &events}
+	require.NoError(s.Export(ctx, "ledger", period, w))
+	require.True(w.closed)
+	require.Equal("ledger-1", w.header.Name)
+	require.Len(w.rows, 1201)
+	require.Equal("1", w.rows[0][0])
+	require.Equal("1201", w.rows[1200][0])
+	// Каждая страница пишется сразу после чтения, а не после всех.
+	require.Equal([]string{"read", "write", "read", "write", "read", "write"}, events)
+
+	events = nil
+	w = &memExportWriter{events: &events}
+	require.NoError(s.Export(ctx, " Bills ", period, w))
+	// Позиция, две доли, оплата и долг на каждый счёт, от старых к новым.
+	require.Len(w.rows, 1201*5)
+	require.Equal("1", w.rows[0][0])
+	require.Equal("1201", w.rows[len(w.rows)-1][0])
+	require.Equal([]string{"read", "write", "read", "write", "read", "write"}, events)
+
+	err := s.Export(ctx, "receipts", period, w)
+	require.ErrorIs(err, exporter.ErrUnknownReport)
+
+	day := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	err = s.Export(ctx, "ledger", exporter.Period{UserID: 1, From: day, To: day}, w)
+	require.ErrorIs(err, exporter.ErrInvalidPeriod)
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+type GroupStorage interface {
+	CreateGroup(context.Context, models.Group) (models.Group, error)
+	GetGroup(context.Context, models.GroupID) (models.Group, error)
+	ListUserGroups(context.Context, models.UserID) ([]models.Group, error)
+	SaveGroupMember(context.Context, models.GroupID, models.GroupMember) error
+	// Состав и балансы для проверки читаются в транзакции удаления.
+	RemoveGroupMember(context.Context, models.GroupID, models.UserID, func(models.Group, models.BalancesByCurrency) error) error
+
+	GetGroupBalances(context.Context, models.GroupID) (models.BalancesByCurrency, error)
+	SaveSettlement(context.Context, models.Settlement, func(models.BalancesByCurrency) (models.Settlement, error)) (models.Settlement, error)
+}
+
+type GroupService struct {
+	storage GroupStorage
+	logger  logger.Logger
+}
+
+func NewGroupService(storage GroupStorage, log logger.Logger) *GroupService {
+	return &GroupService{
+		storage: storage,
+		logger:  log,
+	}
+}
+
+func (s *GroupService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// Создатель становится владельцем группы, остальные - участниками.
+func (s *GroupService) CreateGroup(ctx context.Context, createdBy models.UserID, title string, memberIDs []models.UserID) (models.Group, error) {
+	group := models.Group{
+		Title:     title,
+		CreatedBy: createdBy,
+		Members:   []models.GroupMember{{UserID: createdBy, Role: models.GroupRoleOwner}},
+	}
+
+	for _, userID := range memberIDs {
+		if group.HasMember(userID) {
+			continue
+		}
+
+		var err error
+		if group, err = group.WithMember(models.GroupMember{UserID: userID, Role: models.GroupRoleMember}); err != nil {
+			return models.Group{}, err
+		}
+	}
+
+	if err := group.Validate(); err != nil {
+		return models.Group{}, err
+	}
+
+	return s.storage.CreateGroup(ctx, group)
+}
+
+func (s *GroupService) GetGroup(ctx context.Context, groupID models.GroupID) (models.Group, error) {
+	return s.storage.GetGroup(ctx, groupID)
+}
+
+func (s *GroupService) ListGroups(ctx context.Context, userID models.UserID) ([]models.Group, error) {
+	return s.storage.ListUserGroups(ctx, userID)
+}
+
+// Добавляет участника или меняет его роль. Владелец у группы остаётся всегда.
+func (s *GroupService) SetMember(ctx context.Context, groupID models.GroupID, member models.GroupMember) (models.Group, error) {
+	group, err := s.storage.GetGroup(ctx, groupID)
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	updated, err := group.WithMember(member)
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	if err := s.storage.SaveGroupMember(ctx, groupID, member); err != nil {
+		return models.Group{}, err
+	}
+
+	return updated, nil
+}
+
+// Удалить можно только участника без долгов в группе.
+func (s *GroupService) RemoveMember(ctx context.Context, groupID models.GroupID, userID models.UserID) (models.Group, error) {
+	var updated models.Group
+	check := func(group models.Group, balances models.BalancesByCurrency) error {
+		var err error
+		if updated, err = group.WithoutMember(userID); err != nil {
+			return err
+		}
+
+		for currency, byUser := range balances {
+			if balance, ok := byUser[userID]; ok && !balance.IsZero() {
+				return errors.Wrapf(models.ErrMemberHasBalance, "%s: %s %s", userID, balance, currency)
+			}
+		}
+
+		return nil
+	}
+
+	if err := s.storage.RemoveGroupMember(ctx, groupID, userID, check); err != nil {
+		return models.Group{}, err
+	}
+
+	return updated, nil
+}
+
+// Чистые балансы участников внутри группы.
+func (s *GroupService) GetBalances(ctx context.Context, groupID models.GroupID) (models.BalancesByCurrency, error) {
+	return s.storage.GetGroupBalances(ctx, groupID)
+}
+
+// План взаиморасчётов по балансам группы.
+func (s *GroupService) GetSettlementPlan(ctx context.Context, groupID models.GroupID) ([]models.Invoice, error) {
+	balances, err := s.storage.GetGroupBalances(ctx, groupID)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("group_id", int64(groupID)).
+			Msg("fail to get group balances from storage")
+		return nil, err
+	}
+
+	return settlementPlanByCurrency(balances)
+}
+
+// Записывает перевод payer -> payee в счёт долгов группы. Балансы в группе
+// чистые, поэтому payer должен быть должен группе, а payee - получить с неё;
+// перевод не больше меньшего из двух. Если amount не задан, то переводится
+// этот максимум. Возвращает запись и сколько ещё должен payer группе.
+func (s *GroupService) RecordSettlement(ctx context.Context, recordedBy models.UserID, groupID models.GroupID, payerID, payeeID models.UserID, currency models.Currency, amount *models.Money) (models.Settlement, models.Money, error) {
+	if payerID == payeeID {
+		return models.Settlement{}, models.Money{}, models.ErrSelfSettlement
+	}
+
+	currency = currency.OrDefault()
+	if err := currency.Validate(); err != nil {
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	group, err := s.storage.GetGroup(ctx, groupID)
+	if err != nil {
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	for _, userID := range []models.UserID{payerID, payeeID} {
+		if !group.HasMember(userID) {
+			return models.Settlement{}, models.Money{}, errors.Wrapf(models.ErrNotGroupMember, "%s in %s", userID, groupID)
+		}
+	}
+
+	if err := validateSettlementAmount(amount, currency); err != nil {
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	var payerDebt models.Money
+	check := func(balances models.BalancesByCurrency) (models.Settlement, error) {
+		payerDebt = models.NewMoney()
+		payerDebt.Decimal = payerDebt.Sub(balances[currency][payerID].Decimal)
+		payeeCredit := models.NewMoney()
+		payeeCredit.Decimal = payeeCredit.Add(balances[currency][payeeID].Decimal)
+
+		if !payerDebt.IsPositive() || !payeeCredit.IsPositive() {
+			return models.Settlement{}, errors.Wrapf(models.ErrNoDebt, "%s to %s in %s, %s", payerID, payeeID, groupID, currency)
+		}
+
+		limit := payerDebt
+		if payeeCredit.LessThan(limit.Decimal) {
+			limit = payeeCredit
+		}
+
+		settled, err := settlementAmount(amount, limit)
+		if err != nil {
+			return models.Settlement{}, err
+		}
+
+		return models.Settlement{
+			RecordedBy: recordedBy,
+			PayerID:    payerID,
+			PayeeID:    payeeID,
+			Amount:     settled,
+			Currency:   currency,
+			GroupID:    groupID,
+		}, nil
+	}
+
+	settlement, err := s.storage.SaveSettlement(ctx, models.Settlement{
+		RecordedBy: recordedBy,
+		PayerID:    payerID,
+		PayeeID:    payeeID,
+		Currency:   currency,
+		GroupID:    groupID,
+	}, check)
+	if err != nil {
+		if !isSettlementCheckError(err) {
+			s.log(ctx).Error().Err(err).
+				Int64("group_id", int64(groupID)).
+				Int64("payer_id", int64(payerID)).
+				Int64("payee_id", int64(payeeID)).
+				Msg("fail to save group settlement")
+		}
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	return settlement, models.Money{Decimal: payerDebt.Sub(settlement.Amount.Decimal)}, nil
+}
@@ -0,0 +1,170 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// Одна группа в памяти, проводки группы как Invoice.
+type memGroupStorage struct {
+	group       models.Group
+	entries     []models.Invoice
+	settlements []models.Settlement
+}
+
+func (m *memGroupStorage) CreateGroup(ctx context.Context, group models.Group) (models.Group, error) {
+	group.ID = 1
+	m.group = group
+
+	return group, nil
+}
+
+func (m *memGroupStorage) GetGroup(ctx context.Context, groupID models.GroupID) (models.Group, error) {
+	if groupID != m.group.ID {
+		return models.Group{}, models.ErrGroupNotFound
+	}
+
+	return m.group, nil
+}
+
+func (m *memGroupStorage) ListUserGroups(ctx context.Context, userID models.UserID) ([]models.Group, error) {
+	panic("not used")
+}
+
+func (m *memGroupStorage) SaveGroupMember(ctx context.Context, groupID models.GroupID, member models.GroupMember) error {
+	group, err := m.group.WithMember(member)
+	m.group = group
+
+	return err
+}
+
+func (m *memGroupStorage) RemoveGroupMember(ctx context.Context, groupID models.GroupID, userID models.UserID, check func(models.Group, models.BalancesByCurrency) error) error {
+	balances, err := m.GetGroupBalances(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := check(m.group, balances); err != nil {
+		return err
+	}
+
+	m.group, err = m.group.WithoutMember(userID)
+
+	return err
+}
+
+func (m *memGroupStorage) GetGroupBalances(ctx context.Context, groupID models.GroupID) (models.BalancesByCurrency, error) {
+	res := models.BalancesByCurrency{}
+	for _, e := range m.entries {
+		res.Add(e.Currency.OrDefault(), e.UserFrom, e.Value)
+		res.Add(e.Currency.OrDefault(), e.UserTo, models.Money{Decimal: e.Value.Neg()})
+	}
+
+	return res, nil
+}
+
+func (m *memGroupStorage) SaveSettlement(ctx context.Context, s models.Settlement, check func(models.BalancesByCurrency) (models.Settlement, error)) (models.Settlement, error) {
+	balances, err := m.GetGroupBalances(ctx, s.GroupID)
+	if err != nil {
+		return models.Settlement{}, err
+	}
+
+	if s, err = check(balances); err != nil {
+		return models.Settlement{}, err
+	}
+
+	s.ID = models.SettlementID(len(m.settlements) + 1)
+	m.settlements = append(m.settlements, s)
+	m.entries = append(m.entries, s.ToInvoice())
+
+	return s, nil
+}
+
+func newTestGroupService(t *testing.T) (*services.GroupService, *memGroupStorage) {
+	storage := &memGroupStorage{}
+	log := zerolog.Nop()
+	s := services.NewGroupService(storage, &log)
+
+	_, err := s.CreateGroup(context.Background(), 1, "Квартира", []models.UserID{2, 3, 1})
+	require.NoError(t, err)
+
+	return s, storage
+}
+
+func TestCreateGroup(t *testing.T) {
+	require := require.New(t)
+	s, storage := newTestGroupService(t)
+
+	require.Equal([]models.UserID{1, 2, 3}, storage.group.MemberIDs())
+	owner, _ := storage.group.Member(1)
+	require.Equal(models.GroupRoleOwner, owner.Role)
+
+	_, err := s.CreateGroup(context.Background(), 1, " ", nil)
+	require.ErrorIs(err, models.ErrInvalidGroup)
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	s, storage := newTestGroupService(t)
+
+	// Алиса (1) заплатила за Боба (2) в группе.
+	storage.entries = []models.Invoice{{UserFrom: 1, UserTo: 2, Value: *money("100")}}
+
+	_, err := s.RemoveMember(ctx, 1, 2)
+	require.ErrorIs(err, models.ErrMemberHasBalance)
+
+	_, err = s.RemoveMember(ctx, 1, 1)
+	require.ErrorIs(err, models.ErrLastGroupOwner)
+
+	group, err := s.RemoveMember(ctx, 1, 3)
+	require.NoError(err)
+	require.Equal([]models.UserID{1, 2}, group.MemberIDs())
+
+	_, err = s.RemoveMember(ctx, 1, 3)
+	require.ErrorIs(err, models.ErrNotGroupMember)
+}
+
+func TestGroupSettlement(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	s, storage := newTestGroupService(t)
+
+	// Боб (2) должен Алисе (1), Алиса должна Вике (3): по чистым балансам
+	// Боб отдаёт Вике.
+	storage.entries = []models.Invoice{
+		{UserFrom: 1, UserTo: 2, Value: *money("100")},
+		{UserFrom: 3, UserTo: 1, Value: *money("100")},
+	}
+
+	plan, err := s.GetSettlementPlan(ctx, 1)
+	require.NoError(err)
+	require.Len(plan, 1)
+	require.Equal(models.UserID(3), plan[0].UserFrom)
+	require.Equal(models.UserID(2), plan[0].UserTo)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 1, 2, 1, models.DefaultCurrency, nil)
+	require.ErrorIs(err, models.ErrNoDebt)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 1, 2, 4, models.DefaultCurrency, nil)
+	require.ErrorIs(err, models.ErrNotGroupMember)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 1, 2, 3, models.DefaultCurrency, money("150"))
+	require.ErrorIs(err, models.ErrSettlementExceedsDebt)
+
+	settlement, remaining, err := s.RecordSettlement(ctx, 2, 1, 2, 3, models.DefaultCurrency, nil)
+	require.NoError(err)
+	require.Equal(models.GroupID(1), settlement.GroupID)
+	require.True(settlement.Amount.Equal(decimal.NewFromInt(100)))
+	require.True(remaining.IsZero())
+
+	plan, err = s.GetSettlementPlan(ctx, 1)
+	require.NoError(err)
+	require.Empty(plan)
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+type InviteStorage interface {
+	GetUser(context.Context, models.UserID) (models.User, error)
+	CreatePlaceholderUser(context.Context, models.UserID, string, models.GroupID) (models.User, error)
+
+	CreateInvite(context.Context, models.Invite) (models.Invite, error)
+	GetInvite(context.Context, models.InviteCode) (models.Invite, error)
+	ClaimInvite(context.Context, models.InviteCode, models.UserID, time.Time) (models.Invite, error)
+}
+
+type InviteService struct {
+	storage  InviteStorage
+	logger   logger.Logger
+	ttl      time.Duration
+	linkBase string
+	now      func() time.Time
+}
+
+func NewInviteService(storage InviteStorage, cfg config.Config, log logger.Logger) *InviteService {
+	return &InviteService{
+		storage:  storage,
+		logger:   log,
+		ttl:      cfg.Invites.TTL,
+		linkBase: cfg.Invites.LinkBase,
+		now:      time.Now,
+	}
+}
+
+func (s *InviteService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// Создаёт заглушку и приглашение на неё. С groupID заглушка сразу
+// становится участником группы, и её можно указывать в счетах группы.
+func (s *InviteService) CreatePlaceholder(ctx context.Context, createdBy models.UserID, title string, groupID models.GroupID) (models.User, models.Invite, error) {
+	user := models.User{Title: strings.TrimSpace(title)}
+	if err := user.Validate(); err != nil {
+		return models.User{}, models.Invite{}, err
+	}
+
+	user, err := s.storage.CreatePlaceholderUser(ctx, createdBy, user.Title, groupID)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("created_by", int64(createdBy)).
+			Msg("fail to create placeholder")
+		return models.User{}, models.Invite{}, err
+	}
+
+	invite, err := s.createInvite(ctx, createdBy, groupID, user.ID)
+	if err != nil {
+		return models.User{}, models.Invite{}, err
+	}
+
+	return user, invite, nil
+}
+
+// Новое приглашение в группу и/или на заглушку, например взамен истёкшего.
+func (s *InviteService) CreateInvite(ctx context.Context, createdBy models.UserID, groupID models.GroupID, placeholderID models.UserID) (models.Invite, error) {
+	if placeholderID != 0 {
+		placeholder, err := s.storage.GetUser(ctx, placeholderID)
+		if err != nil {
+			return models.Invite{}, err
+		}
+
+		if !placeholder.Placeholder {
+			return models.Invite{}, errors.Wrapf(models.ErrNotPlaceholder, "%s", placeholderID)
+		}
+
+		if placeholder.ClaimedBy != 0 {
+			return models.Invite{}, errors.Wrapf(models.ErrPlaceholderClaimed, "%s by %s", placeholderID, placeholder.ClaimedBy)
+		}
+
+		if err := placeholder.CheckActive(); err != nil {
+			return models.Invite{}, err
+		}
+	}
+
+	return s.createInvite(ctx, createdBy, groupID, placeholderID)
+}
+
+func (s *InviteService) createInvite(ctx context.Context, createdBy models.UserID, groupID models.GroupID, placeholderID models.UserID) (models.Invite, error) {
+	code, err := models.NewInviteCode()
+	if err != nil {
+		return models.Invite{}, err
+	}
+
+	now := s.now()
+	invite := models.Invite{
+		Code:          code,
+		GroupID:       groupID,
+		PlaceholderID: placeholderID,
+		CreatedBy:     createdBy,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(s.ttl),
+	}
+
+	if err := invite.Validate(); err != nil {
+		return models.Invite{}, err
+	}
+
+	return s.storage.CreateInvite(ctx, invite)
+}
+
+func (s *InviteService) GetUser(ctx context.Context, userID models.UserID) (models.User, error) {
+	return s.storage.GetUser(ctx, userID)
+}
+
+func (s *InviteService) GetInvite(ctx context.Context, code models.InviteCode) (models.Invite, error) {
+	return s.storage.GetInvite(ctx, code)
+}
+
+// Принимает приглашение: userID вступает в группу и забирает счета и долги
+// заглушки.
+func (s *InviteService) ClaimInvite(ctx context.Context, userID models.UserID, code models.InviteCode) (models.Invite, error) {
+	invite, err := s.storage.ClaimInvite(ctx, code, userID, s.now())
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("user_id", int64(userID)).
+			Msg("fail to claim invite")
+		return models.Invite{}, err
+	}
+
+	return invite, nil
+}
+
+// Ссылка-приглашение, пустая, если ссылки не настроены.
+func (s *InviteService) InviteLink(invite models.Invite) string {
+	return invite.Code.Link(s.linkBase)
+}
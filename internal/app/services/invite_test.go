@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type memInviteStorage struct {
+	users   map[models.UserID]models.User
+	invites map[models.InviteCode]models.Invite
+}
+
+func (m *memInviteStorage) GetUser(ctx context.Context, userID models.UserID) (models.User, error) {
+	user, ok := m.users[userID]
+	if !ok {
+		return models.User{}, models.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (m *memInviteStorage) CreatePlaceholderUser(ctx context.Context, createdBy models.UserID, title string, groupID models.GroupID) (models.User, error) {
+	user := models.User{ID: models.UserID(len(m.users) + 1), Title: title, Placeholder: true, CreatedBy: createdBy}
+	m.users[user.ID] = user
+
+	return user, nil
+}
+
+func (m *memInviteStorage) CreateInvite(ctx context.Context, invite models.Invite) (models.Invite, error) {
+	m.invites[invite.Code] = invite
+
+	return invite, nil
+}
+
+func (m *memInviteStorage) GetInvite(ctx context.Context, code models.InviteCode) (models.Invite, error) {
+	invite, ok := m.invites[code]
+	if !ok {
+		return models.Invite{}, models.ErrInviteNotFound
+	}
+
+	return invite, nil
+}
+
+func (m *memInviteStorage) ClaimInvite(ctx context.Context, code models.InviteCode, userID models.UserID, now time.Time) (models.Invite, error) {
+	panic("not used")
+}
+
+func TestCreatePlaceholder(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	storage := &memInviteStorage{
+		users:   map[models.UserID]models.User{1: {ID: 1, Title: "alice"}},
+		invites: map[models.InviteCode]models.Invite{},
+	}
+	log := zerolog.Nop()
+	cfg := config.Config{Invites: config.Invites{TTL: time.Hour, LinkBase: "https://example.org/i/"}}
+	s := services.NewInviteService(storage, cfg, &log)
+
+	_, _, err := s.CreatePlaceholder(ctx, 1, "  ", 0)
+	require.ErrorIs(err, models.ErrInvalidUser)
+
+	placeholder, invite, err := s.CreatePlaceholder(ctx, 1, " Петя ", 0)
+	require.NoError(err)
+	require.Equal("Петя", placeholder.Title)
+	require.True(placeholder.Placeholder)
+	require.Equal(placeholder.ID, invite.PlaceholderID)
+	require.Equal(time.Hour, invite.ExpiresAt.Sub(invite.CreatedAt))
+	require.Equal("https://example.org/i/"+string(invite.Code), s.InviteLink(invite))
+
+	stored, err := s.GetInvite(ctx, invite.Code)
+	require.NoError(err)
+	require.Equal(invite, stored)
+
+	// Настоящего пользователя забрать нельзя.
+	_, err = s.CreateInvite(ctx, 1, 0, 1)
+	require.ErrorIs(err, models.ErrNotPlaceholder)
+
+	_, err = s.CreateInvite(ctx, 1, 0, 0)
+	require.ErrorIs(err, models.ErrInvalidInvite)
+
+	claimed := storage.users[placeholder.ID]
+	claimed.ClaimedBy = 1
+	storage.users[placeholder.ID] = claimed
+	_, err = s.CreateInvite(ctx, 1, 0, placeholder.ID)
+	require.ErrorIs(err, models.ErrPlaceholderClaimed)
+
+	// Заглушку, слитую с другим пользователем, тоже.
+	merged, _, err := s.CreatePlaceholder(ctx, 1, "Петя 2", 0)
+	require.NoError(err)
+	merged.MergedInto = 1
+	storage.users[merged.ID] = merged
+	_, err = s.CreateInvite(ctx, 1, 0, merged.ID)
+	require.ErrorIs(err, models.ErrUserMerged)
+
+	// И деактивированную.
+	deactivated, _, err := s.CreatePlaceholder(ctx, 1, "Петя 3", 0)
+	require.NoError(err)
+	deactivated.DeactivatedAt = time.Now()
+	storage.users[deactivated.ID] = deactivated
+	_, err = s.CreateInvite(ctx, 1, 0, deactivated.ID)
+	require.ErrorIs(err, models.ErrUserDeactivated)
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+type SettlementStorage interface {
+	GetNetBalances(context.Context, []models.UserID) (models.BalancesByCurrency, error)
+	GetUserBalances(context.Context, models.UserID) (models.BalancesByCurrency, error)
+
+	// Балансы для проверки читаются в транзакции записи, см. pgsql.SaveSettlement.
+	SaveSettlement(context.Context, models.Settlement, func(models.BalancesByCurrency) (models.Settlement, error)) (models.Settlement, error)
+	ListSettlements(context.Context, models.UserID, models.SettlementListFilter) ([]models.Settlement, error)
+}
+
+type SettlementService struct {
+	storage SettlementStorage
+	logger  logger.Logger
+}
+
+func NewSettlementService(storage SettlementStorage, log logger.Logger) *SettlementService {
+	return &SettlementService{
+		storage: storage,
+		logger:  log,
+	}
+}
+
+func (s *SettlementService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// План взаиморасчётов внутри компании пользователей с минимумом переводов.
+// Каждая валюта гасится отдельно, переводы упорядочены по коду валюты.
+func (s *SettlementService) GetSettlementPlan(ctx context.Context, userIDs []models.UserID) ([]models.Invoice, error) {
+	balances, err := s.storage.GetNetBalances(ctx, userIDs)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int("users_count", len(userIDs)).
+			Msg("fail to get net balances from storage")
+		return nil, err
+	}
+
+	return settlementPlanByCurrency(balances)
+}
+
+// Планы по каждой валюте, упорядоченные по коду валюты.
+func settlementPlanByCurrency(balances models.BalancesByCurrency) ([]models.Invoice, error) {
+	currencies := make([]models.Currency, 0, len(balances))
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i] < currencies[j]
+	})
+
+	plan := []models.Invoice{}
+	for _, currency := range currencies {
+		invoices, err := models.SettlementPlan(balances[currency], currency)
+		if err != nil {
+			return nil, errors.Wrapf(err, "currency %s", currency)
+		}
+
+		plan = append(plan, invoices...)
+	}
+
+	return plan, nil
+}
+
+// Записывает возврат долга payer -> payee в валюте currency. Если amount не
+// задан, то гасится весь текущий долг в этой валюте. Возвращает запись и
+// остаток долга.
+func (s *SettlementService) RecordSettlement(ctx context.Context, recordedBy, payerID, payeeID models.UserID, currency models.Currency, amount *models.Money) (models.Settlement, models.Money, error) {
+	if payerID == payeeID {
+		return models.Settlement{}, models.Money{}, models.ErrSelfSettlement
+	}
+
+	currency = currency.OrDefault()
+	if err := currency.Validate(); err != nil {
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	if err := validateSettlementAmount(amount, currency); err != nil {
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	// Долг проверяется по балансам в транзакции записи, иначе параллельные
+	// погашения могут вместе превысить его.
+	var debt models.Money
+	check := func(balances models.BalancesByCurrency) (models.Settlement, error) {
+		// Баланс плательщика с получателем отрицательный, если плательщик должен.
+		debt = models.NewMoney()
+		if balance, ok := balances[currency][payeeID]; ok {
+			debt.Decimal = debt.Sub(balance.Decimal)
+		}
+
+		if !debt.IsPositive() {
+			return models.Settlement{}, errors.Wrapf(models.ErrNoDebt, "%s to %s in %s", payerID, payeeID, currency)
+		}
+
+		settled, err := settlementAmount(amount, debt)
+		if err != nil {
+			return models.Settlement{}, err
+		}
+
+		return models.Settlement{
+			RecordedBy: recordedBy,
+			PayerID:    payerID,
+			PayeeID:    payeeID,
+			Amount:     settled,
+			Currency:   currency,
+		}, nil
+	}
+
+	settlement, err := s.storage.SaveSettlement(ctx, models.Settlement{
+		RecordedBy: recordedBy,
+		PayerID:    payerID,
+		PayeeID:    payeeID,
+		Currency:   currency,
+	}, check)
+	if err != nil {
+		if !isSettlementCheckError(err) {
+			s.log(ctx).Error().Err(err).
+				Int64("payer_id", int64(payerID)).
+				Int64("payee_id", int64(payeeID)).
+				Msg("fail to save settlement")
+		}
+		return models.Settlement{}, models.Money{}, err
+	}
+
+	return settlement, models.Money{Decimal: debt.Sub(settlement.Amount.Decimal)}, nil
+}
+
+func validateSettlementAmount(amount *models.Money, currency models.Currency) error {
+	if amount == nil {
+		return nil
+	}
+
+	if err := amount.Validate(currency); err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return models.ErrNonPositiveAmount
+	}
+
+	return nil
+}
+
+// Сумма погашения не больше limit. Без amount гасится весь limit.
+func settlementAmount(amount *models.Money, limit models.Money) (models.Money, error) {
+	if amount == nil {
+		return limit, nil
+	}
+
+	if amount.GreaterThan(limit.Decimal) {
+		return models.Money{}, errors.Wrapf(models.ErrSettlementExceedsDebt, "amount %s, limit %s", amount, limit)
+	}
+
+	return *amount, nil
+}
+
+// Ошибки проверки долга не логируются: это ответ пользователю, а не сбой.
+func isSettlementCheckError(err error) bool {
+	return errors.Is(err, models.ErrNoDebt) || errors.Is(err, models.ErrSettlementExceedsDebt)
+}
+
+func (s *SettlementService) ListSettlements(ctx context.Context, userID models.UserID, filter models.SettlementListFilter) ([]models.Settlement, error) {
+	return s.storage.ListSettlements(ctx, userID, filter)
+}
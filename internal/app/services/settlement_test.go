@@ -0,0 +1,169 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// Хранилище в памяти: проводки как Invoice. SaveSettlement под мьютексом,
+// как транзакция с блокировкой пары в pgsql.
+type memSettlementStorage struct {
+	mu          sync.Mutex
+	entries     []models.Invoice
+	settlements []models.Settlement
+}
+
+func (m *memSettlementStorage) GetNetBalances(ctx context.Context, userIDs []models.UserID) (models.BalancesByCurrency, error) {
+	panic("not used")
+}
+
+func (m *memSettlementStorage) GetUserBalances(ctx context.Context, userID models.UserID) (models.BalancesByCurrency, error) {
+	res := models.BalancesByCurrency{}
+	for _, e := range m.entries {
+		switch userID {
+		case e.UserFrom:
+			res.Add(e.Currency.OrDefault(), e.UserTo, e.Value)
+		case e.UserTo:
+			res.Add(e.Currency.OrDefault(), e.UserFrom, models.Money{Decimal: e.Value.Neg()})
+		}
+	}
+
+	return res, nil
+}
+
+func (m *memSettlementStorage) SaveSettlement(ctx context.Context, s models.Settlement, check func(models.BalancesByCurrency) (models.Settlement, error)) (models.Settlement, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	balances, err := m.GetUserBalances(ctx, s.PayerID)
+	if err != nil {
+		return models.Settlement{}, err
+	}
+
+	if s, err = check(balances); err != nil {
+		return models.Settlement{}, err
+	}
+
+	s.ID = models.SettlementID(len(m.settlements) + 1)
+	m.settlements = append(m.settlements, s)
+	m.entries = append(m.entries, s.ToInvoice())
+
+	return s, nil
+}
+
+func (m *memSettlementStorage) ListSettlements(ctx context.Context, userID models.UserID, filter models.SettlementListFilter) ([]models.Settlement, error) {
+	return m.settlements, nil
+}
+
+func money(v string) *models.Money {
+	return &models.Money{Decimal: decimal.RequireFromString(v)}
+}
+
+func TestRecordSettlement(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Алиса (1) заплатила за Боба (2) 100.
+	storage := &memSettlementStorage{
+		entries: []models.Invoice{{UserFrom: 1, UserTo: 2, Value: *money("100")}},
+	}
+	log := zerolog.Nop()
+	s := services.NewSettlementService(storage, &log)
+
+	// Алиса Бобу ничего не должна.
+	_, _, err := s.RecordSettlement(ctx, 1, 1, 2, "", nil)
+	require.ErrorIs(err, models.ErrNoDebt)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 2, 1, models.DefaultCurrency, money("150"))
+	require.ErrorIs(err, models.ErrSettlementExceedsDebt)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 2, 1, models.DefaultCurrency, money("-1"))
+	require.ErrorIs(err, models.ErrNonPositiveAmount)
+
+	// Частичный возврат.
+	settlement, remaining, err := s.RecordSettlement(ctx, 2, 2, 1, models.DefaultCurrency, money("30"))
+	require.NoError(err)
+	require.True(settlement.Amount.Equal(decimal.NewFromInt(30)))
+	require.True(remaining.Equal(decimal.NewFromInt(70)), "remaining %s", remaining)
+
+	// Остаток целиком.
+	settlement, remaining, err = s.RecordSettlement(ctx, 1, 2, 1, models.DefaultCurrency, nil)
+	require.NoError(err)
+	require.True(settlement.Amount.Equal(decimal.NewFromInt(70)))
+	require.True(remaining.IsZero())
+
+	balances, err := storage.GetUserBalances(ctx, 2)
+	require.NoError(err)
+	require.True(balances[models.DefaultCurrency][1].IsZero())
+}
+
+func TestRecordSettlementCurrency(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Долг Боба в иенах, в рублях он ничего не должен.
+	storage := &memSettlementStorage{
+		entries: []models.Invoice{{UserFrom: 1, UserTo: 2, Value: *money("1000"), Currency: "JPY"}},
+	}
+	log := zerolog.Nop()
+	s := services.NewSettlementService(storage, &log)
+
+	_, _, err := s.RecordSettlement(ctx, 2, 2, 1, models.DefaultCurrency, nil)
+	require.ErrorIs(err, models.ErrNoDebt)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 2, 1, "JPY", money("0.5"))
+	require.ErrorIs(err, models.ErrMoneyPrecision)
+
+	_, _, err = s.RecordSettlement(ctx, 2, 2, 1, "XXX", nil)
+	require.ErrorIs(err, models.ErrUnknownCurrency)
+
+	settlement, remaining, err := s.RecordSettlement(ctx, 2, 2, 1, "JPY", money("400"))
+	require.NoError(err)
+	require.Equal(models.Currency("JPY"), settlement.Currency)
+	require.True(remaining.Equal(decimal.NewFromInt(600)), "remaining %s", remaining)
+}
+
+func TestRecordSettlementConcurrent(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// Боб (2) должен Алисе (1) 100 и одновременно отправляет десять
+	// погашений по 30: пройти могут только три.
+	storage := &memSettlementStorage{
+		entries: []models.Invoice{{UserFrom: 1, UserTo: 2, Value: *money("100")}},
+	}
+	log := zerolog.Nop()
+	s := services.NewSettlementService(storage, &log)
+
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _, errs[i] = s.RecordSettlement(ctx, 2, 2, 1, models.DefaultCurrency, money("30"))
+		}(i)
+	}
+	wg.Wait()
+
+	saved := 0
+	for _, err := range errs {
+		if err == nil {
+			saved++
+			continue
+		}
+		require.ErrorIs(err, models.ErrSettlementExceedsDebt)
+	}
+	require.Equal(3, saved)
+
+	balances, err := storage.GetUserBalances(ctx, 2)
+	require.NoError(err)
+	require.True(balances[models.DefaultCurrency][1].Equal(decimal.NewFromInt(-10)), "balance %s", balances[models.DefaultCurrency][1])
+}
@@ -12,9 +12,12 @@ type SplitTheBillStorage interface {
 
 	// Bill
 	SaveSplittedBill(context.Context, models.UserID, models.Bill) (models.BillID, error)
-	ListUserBills(context.Context, models.UserID) ([]models.Bill, error)
+	ListUserBills(context.Context, models.UserID, models.BillListFilter) ([]models.Bill, error)
 	GetBills(context.Context, []models.BillID) ([]models.Bill, error)
 	DeleteBills(context.Context, []models.BillID) ([]models.Bill, error)
+	UpdateSplittedBill(context.Context, models.UserID, models.BillID, int, models.Bill) (models.Bill, error)
+	GetBillRevisions(context.Context, models.BillID) ([]models.BillRevision, error)
+	UpgradeBillsSchema(context.Context, models.BillUpgradeOptions) (models.BillUpgradeReport, error)
 }
 
 type SplitTheBillService struct {
@@ -30,3 +33,45 @@ func NewSplitTheBillService(storage SplitTheBillStorage) *SplitTheBillService {
 func (s *SplitTheBillService) SaveBill(ctx context.Context, userID models.UserID, bill models.Bill) (models.BillID, error) {
 	return s.storage.SaveSplittedBill(ctx, userID, bill)
 }
+
+func (s *SplitTheBillService) GetBill(ctx context.Context, billID models.BillID) (models.Bill, error) {
+	bills, err := s.storage.GetBills(ctx, []models.BillID{billID})
+	if err != nil {
+		return models.Bill{}, err
+	}
+
+	return bills[0], nil
+}
+
+func (s *SplitTheBillService) ListBills(ctx context.Context, userID models.UserID, filter models.BillListFilter) ([]models.Bill, error) {
+	return s.storage.ListUserBills(ctx, userID, filter)
+}
+
+func (s *SplitTheBillService) DeleteBill(ctx context.Context, billID models.BillID) (models.Bill, error) {
+	bills, err := s.storage.DeleteBills(ctx, []models.BillID{billID})
+	if err != nil {
+		return models.Bill{}, err
+	}
+
+	return bills[0], nil
+}
+
+// Сохраняет новую ревизию счёта, если с revision его никто не менял.
+func (s *SplitTheBillService) UpdateBill(ctx context.Context, editorID models.UserID, billID models.BillID, revision int, bill models.Bill) (models.Bill, error) {
+	return s.storage.UpdateSplittedBill(ctx, editorID, billID, revision, bill)
+}
+
+// Все версии счёта с отличиями каждой от предыдущей.
+func (s *SplitTheBillService) GetBillHistory(ctx context.Context, billID models.BillID) ([]models.BillHistoryEntry, error) {
+	revisions, err := s.storage.GetBillRevisions(ctx, billID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.BillHistory(revisions), nil
+}
+
+// Переписывает сохранённые счета на текущую версию схемы.
+func (s *SplitTheBillService) UpgradeBillsSchema(ctx context.Context, opts models.BillUpgradeOptions) (models.BillUpgradeReport, error) {
+	return s.storage.UpgradeBillsSchema(ctx, opts)
+}
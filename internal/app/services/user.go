@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+)
+
+type UserStorage interface {
+	GetUser(context.Context, models.UserID) (models.User, error)
+	RegisterUser(context.Context, models.User) (models.User, error)
+	RenameUser(context.Context, models.UserID, string) (models.User, error)
+	SearchUsers(context.Context, string, uint64) ([]models.User, error)
+	DeactivateUser(context.Context, models.UserID, time.Time) (models.User, error)
+	GetUserContacts(context.Context, models.UserID) ([]models.UserID, error)
+
+	MergeUsers(context.Context, models.UserID, models.UserID, bool) (models.UserMerge, error)
+}
+
+// Управление пользователями для внутренних нужд (CLI и InternalService)
+// и контакты пользователя для проверок доступа.
+type UserService struct {
+	storage UserStorage
+	logger  logger.Logger
+	now     func() time.Time
+}
+
+func NewUserService(storage UserStorage, log logger.Logger) *UserService {
+	return &UserService{
+		storage: storage,
+		logger:  log,
+		now:     time.Now,
+	}
+}
+
+func (s *UserService) log(ctx context.Context) logger.Logger {
+	return logger.FromCtxOrDefault(ctx, s.logger)
+}
+
+// Создаёт пользователя. external может быть пустым.
+func (s *UserService) NewUser(ctx context.Context, title string, external models.ExternalIdentity) (models.User, error) {
+	user := models.User{
+		Title: strings.TrimSpace(title),
+		External: models.ExternalIdentity{
+			Provider: strings.TrimSpace(external.Provider),
+			Subject:  strings.TrimSpace(external.Subject),
+		},
+	}
+	if err := user.Validate(); err != nil {
+		return models.User{}, err
+	}
+
+	created, err := s.storage.RegisterUser(ctx, user)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Str("title", user.Title).
+			Msg("fail to create user")
+		return models.User{}, err
+	}
+
+	s.log(ctx).Info().
+		Int64("user_id", int64(created.ID)).
+		Msg("user created")
+
+	return created, nil
+}
+
+func (s *UserService) GetUser(ctx context.Context, userID models.UserID) (models.User, error) {
+	return s.storage.GetUser(ctx, userID)
+}
+
+func (s *UserService) RenameUser(ctx context.Context, userID models.UserID, title string) (models.User, error) {
+	user := models.User{ID: userID, Title: strings.TrimSpace(title)}
+	if err := user.Validate(); err != nil {
+		return models.User{}, err
+	}
+
+	return s.storage.RenameUser(ctx, userID, user.Title)
+}
+
+// Поиск по началу имени среди активных пользователей.
+func (s *UserService) SearchUsers(ctx context.Context, prefix string, limit uint64) ([]models.User, error) {
+	return s.storage.SearchUsers(ctx, strings.TrimSpace(prefix), limit)
+}
+
+// С кем пользователь уже связан, для проверок authz: на кого он может
+// записывать долги.
+func (s *UserService) GetContacts(ctx context.Context, userID models.UserID) (authz.Contacts, error) {
+	userIDs, err := s.storage.GetUserContacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return authz.NewContacts(userIDs...), nil
+}
+
+// Деактивированный пользователь остаётся в счетах и проводках,
+// но его нельзя менять и не видно в поиске.
+func (s *UserService) DeactivateUser(ctx context.Context, userID models.UserID) (models.User, error) {
+	user, err := s.storage.DeactivateUser(ctx, userID, s.now())
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("user_id", int64(userID)).
+			Msg("fail to deactivate user")
+		return models.User{}, err
+	}
+
+	return user, nil
+}
+
+// Сливает дубль sourceID в targetID: счета, проводки, погашения и группы
+// переходят к targetID. С dryRun только считает, что будет перенесено.
+func (s *UserService) MergeUsers(ctx context.Context, targetID, sourceID models.UserID, dryRun bool) (models.UserMerge, error) {
+	merge := models.UserMerge{TargetID: targetID, SourceID: sourceID}
+	if err := merge.Validate(); err != nil {
+		return models.UserMerge{}, err
+	}
+
+	merge, err := s.storage.MergeUsers(ctx, targetID, sourceID, dryRun)
+	if err != nil {
+		s.log(ctx).Error().Err(err).
+			Int64("target_id", int64(targetID)).
+			Int64("source_id", int64(sourceID)).
+			Msg("fail to merge users")
+		return models.UserMerge{}, err
+	}
+
+	if !dryRun {
+		s.log(ctx).Info().
+			Int64("merge_id", merge.ID).
+			Int64("target_id", int64(targetID)).
+			Int64("source_id", int64(sourceID)).
+			Int64("entries_moved", merge.EntriesMoved).
+			Int64("entries_dropped", merge.EntriesDropped).
+			Int64("bills_rewritten", merge.BillsRewritten).
+			Msg("users merged")
+	}
+
+	return merge, nil
+}
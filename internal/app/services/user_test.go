@@ -0,0 +1,167 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type memUserStorage struct {
+	users map[models.UserID]models.User
+}
+
+func (m *memUserStorage) GetUser(ctx context.Context, userID models.UserID) (models.User, error) {
+	user, ok := m.users[userID]
+	if !ok {
+		return models.User{}, models.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (m *memUserStorage) RegisterUser(ctx context.Context, user models.User) (models.User, error) {
+	for _, u := range m.users {
+		if u.Title == user.Title || (!user.External.IsZero() && u.External == user.External) {
+			return models.User{}, models.ErrUserExists
+		}
+	}
+
+	user.ID = models.UserID(len(m.users) + 1)
+	m.users[user.ID] = user
+
+	return user, nil
+}
+
+func (m *memUserStorage) RenameUser(ctx context.Context, userID models.UserID, title string) (models.User, error) {
+	user, err := m.GetUser(ctx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if err := user.CheckActive(); err != nil {
+		return models.User{}, err
+	}
+
+	user.Title = title
+	m.users[userID] = user
+
+	return user, nil
+}
+
+func (m *memUserStorage) SearchUsers(ctx context.Context, prefix string, limit uint64) ([]models.User, error) {
+	var res []models.User
+	for _, u := range m.users {
+		if u.Active() && strings.HasPrefix(strings.ToLower(u.Title), strings.ToLower(prefix)) {
+			res = append(res, u)
+		}
+	}
+
+	return res, nil
+}
+
+func (m *memUserStorage) DeactivateUser(ctx context.Context, userID models.UserID, now time.Time) (models.User, error) {
+	user, err := m.GetUser(ctx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if user.DeactivatedAt.IsZero() {
+		user.DeactivatedAt = now
+		m.users[userID] = user
+	}
+
+	return user, nil
+}
+
+func (m *memUserStorage) GetUserContacts(ctx context.Context, userID models.UserID) ([]models.UserID, error) {
+	var res []models.UserID
+	for _, u := range m.users {
+		if u.Placeholder && u.CreatedBy == userID {
+			res = append(res, u.ID)
+		}
+	}
+
+	return res, nil
+}
+
+func (m *memUserStorage) MergeUsers(ctx context.Context, targetID, sourceID models.UserID, dryRun bool) (models.UserMerge, error) {
+	return models.UserMerge{TargetID: targetID, SourceID: sourceID, DryRun: dryRun}, nil
+}
+
+func TestUserLifecycle(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	storage := &memUserStorage{users: map[models.UserID]models.User{}}
+	log := zerolog.Nop()
+	s := services.NewUserService(storage, &log)
+
+	_, err := s.NewUser(ctx, " ", models.ExternalIdentity{})
+	require.ErrorIs(err, models.ErrInvalidUser)
+
+	_, err = s.NewUser(ctx, "alice", models.ExternalIdentity{Provider: "telegram"})
+	require.ErrorIs(err, models.ErrInvalidUser)
+
+	alice, err := s.NewUser(ctx, " alice ", models.ExternalIdentity{Provider: "telegram", Subject: " 42 "})
+	require.NoError(err)
+	require.Equal("alice", alice.Title)
+	require.Equal(models.ExternalIdentity{Provider: "telegram", Subject: "42"}, alice.External)
+
+	_, err = s.NewUser(ctx, "alice2", models.ExternalIdentity{Provider: "telegram", Subject: "42"})
+	require.ErrorIs(err, models.ErrUserExists)
+
+	_, err = s.RenameUser(ctx, alice.ID, "")
+	require.ErrorIs(err, models.ErrInvalidUser)
+
+	alice, err = s.RenameUser(ctx, alice.ID, " Alice ")
+	require.NoError(err)
+	require.Equal("Alice", alice.Title)
+
+	found, err := s.SearchUsers(ctx, "al", 10)
+	require.NoError(err)
+	require.Len(found, 1)
+
+	alice, err = s.DeactivateUser(ctx, alice.ID)
+	require.NoError(err)
+	require.False(alice.Active())
+
+	found, err = s.SearchUsers(ctx, "al", 10)
+	require.NoError(err)
+	require.Empty(found)
+
+	_, err = s.RenameUser(ctx, alice.ID, "Alice B")
+	require.ErrorIs(err, models.ErrUserDeactivated)
+}
+
+func TestMergeUsersRejectsSelfMerge(t *testing.T) {
+	require := require.New(t)
+
+	log := zerolog.Nop()
+	s := services.NewUserService(&memUserStorage{}, &log)
+
+	_, err := s.MergeUsers(context.Background(), 1, 1, false)
+	require.ErrorIs(err, models.ErrSelfMerge)
+}
+
+func TestGetContacts(t *testing.T) {
+	require := require.New(t)
+
+	storage := &memUserStorage{users: map[models.UserID]models.User{
+		1: {ID: 1, Title: "alice"},
+		2: {ID: 2, Title: "Бабушка", Placeholder: true, CreatedBy: 1},
+		3: {ID: 3, Title: "Дедушка", Placeholder: true, CreatedBy: 4},
+	}}
+	log := zerolog.Nop()
+	s := services.NewUserService(storage, &log)
+
+	contacts, err := s.GetContacts(context.Background(), 1)
+	require.NoError(err)
+	require.True(contacts.Has(2))
+	require.False(contacts.Has(3))
+}
@@ -2,43 +2,61 @@ package pgsql
 
 import (
 	"context"
+	"database/sql"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
-func (s *Storage) GetUserAccount(ctx context.Context, userID models.UserID) (models.Account, error) {
-	var balance models.Account
-
-	err := psql.Select("debit.amount, credit.amount").
-		From("debit, credit").
-		Prefix(`
-			WITH debit as (
-				SELECT
-					COALESCE(sum(amount), 0) as amount
-				FROM accounting_entries
-				WHERE user_to = ?
-			), credit as (
-				SELECT
-					COALESCE(sum(amount), 0) as amount
-				FROM accounting_entries
-				WHERE user_from = ?
-			)`, userID, userID).
+// Дебет и кредит пользователя отдельно по каждой валюте.
+func (s *Storage) GetUserAccount(ctx context.Context, userID models.UserID) (map[models.Currency]models.Account, error) {
+	rows, err := psql.
+		Select("currency").
+		Column("COALESCE(sum(amount) FILTER (WHERE user_to = ?), 0)", userID).
+		Column("COALESCE(sum(amount) FILTER (WHERE user_from = ?), 0)", userID).
+		From("accounting_entries").
+		Where(squirrel.Or{
+			squirrel.Eq{"user_to": userID},
+			squirrel.Eq{"user_from": userID},
+		}).
+		GroupBy("currency").
 		RunWith(s.pool).
-		QueryRow().
-		Scan(&balance.Debit, &balance.Credit)
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
 
-	return balance, errors.WithStack(err)
+	accounts := make(map[models.Currency]models.Account)
+	for rows.Next() {
+		var currency models.Currency
+		var account models.Account
+		if err := rows.Scan(&currency, &account.Debit, &account.Credit); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		accounts[currency] = account
+	}
+
+	return accounts, errors.WithStack(rows.Err())
 }
 
-func (s *Storage) GetUserBalances(ctx context.Context, userID models.UserID) (map[models.UserID]models.Money, error) {
-	rows, err := psql.
-		Select("user_id, COALESCE(sum(amount), 0)").
+func (s *Storage) GetUserBalances(ctx context.Context, userID models.UserID) (models.BalancesByCurrency, error) {
+	return selectUserBalances(ctx, s.pool, userID)
+}
+
+func selectUserBalances(ctx context.Context, q sqlx.QueryerContext, userID models.UserID) (models.BalancesByCurrency, error) {
+	query, args, err := psql.
+		Select("currency, user_id, COALESCE(sum(amount), 0)").
 		From("balances").
-		GroupBy("user_id").
+		GroupBy("currency, user_id").
 		Prefix(`
 			WITH balances as (
 				SELECT
+					currency,
 					user_to as user_id,
 					amount
 				FROM accounting_entries
@@ -47,18 +65,97 @@ func (s *Storage) GetUserBalances(ctx context.Context, userID models.UserID) (ma
 				UNION ALL
 
 				SELECT
+					currency,
 					user_from as user_id,
 					- amount
 				FROM accounting_entries
 				WHERE user_to = ?
 			)`, userID, userID).
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	return scanBalancesByCurrency(rows)
+}
+
+// Чистые балансы пользователей с учётом только проводок между ними самими.
+// Сумма балансов в каждой валюте нулевая. Пользователей без проводок в ответе нет.
+func (s *Storage) GetNetBalances(ctx context.Context, userIDs []models.UserID) (models.BalancesByCurrency, error) {
+	if len(userIDs) == 0 {
+		return models.BalancesByCurrency{}, nil
+	}
+
+	inSet, inSetArgs, err := squirrel.And{
+		squirrel.Eq{"user_from": userIDs},
+		squirrel.Eq{"user_to": userIDs},
+	}.ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rows, err := psql.
+		Select("currency, user_id, COALESCE(sum(amount), 0)").
+		From("balances").
+		GroupBy("currency, user_id").
+		Prefix(`
+			WITH entries as (
+				SELECT
+					currency,
+					user_from,
+					user_to,
+					amount
+				FROM accounting_entries
+				WHERE `+inSet+`
+			), balances as (
+				SELECT
+					currency,
+					user_from as user_id,
+					amount
+				FROM entries
+
+				UNION ALL
+
+				SELECT
+					currency,
+					user_to as user_id,
+					- amount
+				FROM entries
+			)`, inSetArgs...).
 		RunWith(s.pool).
-		Query()
+		QueryContext(ctx)
 
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 	defer rows.Close()
 
-	return scanToMap[models.UserID, models.Money](rows)
+	return scanBalancesByCurrency(rows)
+}
+
+func scanBalancesByCurrency(rows *sql.Rows) (models.BalancesByCurrency, error) {
+	result := models.BalancesByCurrency{}
+
+	for rows.Next() {
+		var currency models.Currency
+		var userID models.UserID
+		var amount models.Money
+		if err := rows.Scan(&currency, &userID, &amount); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if _, ok := result[currency][userID]; ok {
+			return nil, errors.New("query result is not unique by key")
+		}
+
+		result.Add(currency, userID, amount)
+	}
+
+	return result, errors.WithStack(rows.Err())
 }
@@ -0,0 +1,99 @@
+package pgsql
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Счета, уже созданные ownerID из строк с такими хэшами: хэш -> счёт.
+func (s *Storage) GetImportedBillIDs(ctx context.Context, ownerID models.UserID, hashes []string) (map[string]models.BillID, error) {
+	return selectImportedBillIDs(ctx, s.pool, ownerID, hashes)
+}
+
+func selectImportedBillIDs(ctx context.Context, q sqlx.QueryerContext, ownerID models.UserID, hashes []string) (map[string]models.BillID, error) {
+	if len(hashes) == 0 {
+		return map[string]models.BillID{}, nil
+	}
+
+	query, args, err := psql.Select("source_hash", "bill_id").
+		From("bill_imports").
+		Where(squirrel.Eq{"owner_id": ownerID, "source_hash": hashes}).
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	return scanToMap[string, models.BillID](rows)
+}
+
+// Сохраняет счета одной транзакцией. Строки, загруженные раньше тем же
+// владельцем (в том числе параллельно), пропускаются. Возвращает созданные счета в порядке
+// bills и число пропущенных.
+func (s *Storage) SaveImportedBills(ctx context.Context, ownerID models.UserID, bills []models.ImportedBill) ([]models.BillID, int, error) {
+	if len(bills) == 0 {
+		return nil, 0, nil
+	}
+
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	// Две загрузки одного файла ждут друг друга, а не падают на ключе.
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE bill_imports IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	hashes := make([]string, 0, len(bills))
+	for _, ib := range bills {
+		hashes = append(hashes, ib.SourceHash)
+	}
+
+	existing, err := selectImportedBillIDs(ctx, tx, ownerID, hashes)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var imported []models.BillID
+	skipped := 0
+	for _, ib := range bills {
+		if _, ok := existing[ib.SourceHash]; ok {
+			skipped++
+			continue
+		}
+
+		billID, err := saveSplittedBill(ctx, tx, ownerID, ib.Bill, ib.Bill.CreatedAt)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "line %d", ib.Line)
+		}
+
+		_, err = psql.Insert("bill_imports").
+			Columns("owner_id", "source_hash", "bill_id").
+			Values(ownerID, ib.SourceHash, billID).
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+
+		existing[ib.SourceHash] = billID
+		imported = append(imported, billID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	return imported, skipped, nil
+}
@@ -0,0 +1,177 @@
+package pgsql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+// JSON счёта произвольной версии. Числа остаются json.Number, чтобы апгрейд
+// не терял точность.
+type billDoc map[string]any
+
+// Версия схемы счёта: как из JSON этой версии получить JSON следующей.
+// У CurrentBillSchemaVersion апгрейда нет, её читает dbBill.Scan.
+type billSchema struct {
+	upgrade func(billDoc) error
+}
+
+var billSchemas = map[int]billSchema{
+	1: {upgrade: upgradeBillV1},
+	2: {},
+}
+
+// v1 -> v2: Quantity из целого числа стал decimal, который пишется строкой.
+func upgradeBillV1(doc billDoc) error {
+	items, _ := doc["Items"].([]any)
+	for i, v := range items {
+		item, ok := v.(map[string]any)
+		if !ok {
+			return errors.Errorf("item at index %d is not an object", i)
+		}
+
+		quantity, ok := item["Quantity"].(json.Number)
+		if !ok {
+			return errors.Errorf("item at index %d: Quantity is not a number", i)
+		}
+
+		item["Quantity"] = quantity.String()
+	}
+
+	return nil
+}
+
+// Читает счёт версии schemaVersion, по цепочке приводя его к текущей.
+func decodeBill(schemaVersion int, data []byte) (models.Bill, error) {
+	if _, ok := billSchemas[schemaVersion]; !ok || schemaVersion > models.CurrentBillSchemaVersion {
+		return models.Bill{}, errors.Wrapf(ErrUnknownBillSchemaVersion, "version %d", schemaVersion)
+	}
+
+	if schemaVersion < models.CurrentBillSchemaVersion {
+		var err error
+		if data, err = upgradeBillData(schemaVersion, data); err != nil {
+			return models.Bill{}, err
+		}
+	}
+
+	var b dbBill
+	if err := b.Scan(data); err != nil {
+		return models.Bill{}, err
+	}
+
+	return models.Bill(b), nil
+}
+
+func upgradeBillData(schemaVersion int, data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc billDoc
+	if err := dec.Decode(&doc); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for v := schemaVersion; v < models.CurrentBillSchemaVersion; v++ {
+		schema, ok := billSchemas[v]
+		if !ok || schema.upgrade == nil {
+			return nil, errors.Wrapf(ErrUnknownBillSchemaVersion, "no upgrade from version %d", v)
+		}
+
+		if err := schema.upgrade(doc); err != nil {
+			return nil, errors.Wrapf(err, "fail upgrade from version %d", v)
+		}
+	}
+
+	res, err := json.Marshal(doc)
+	return res, errors.WithStack(err)
+}
+
+// Переписывает счета старых версий схемы на текущую, пачками по
+// opts.BatchSize, каждая пачка - в своей транзакции. Счета, которые не
+// читаются или невалидны после апгрейда, не трогает и возвращает в отчёте.
+func (s *Storage) UpgradeBillsSchema(ctx context.Context, opts models.BillUpgradeOptions) (models.BillUpgradeReport, error) {
+	report := models.BillUpgradeReport{}
+
+	var afterID models.BillID
+	for {
+		n, lastID, err := s.upgradeBillsBatch(ctx, afterID, opts, &report)
+		if err != nil {
+			return report, err
+		}
+
+		if n == 0 {
+			return report, nil
+		}
+
+		afterID = lastID
+	}
+}
+
+func (s *Storage) upgradeBillsBatch(ctx context.Context, afterID models.BillID, opts models.BillUpgradeOptions, report *models.BillUpgradeReport) (int, models.BillID, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	records, err := selectBillRecords(ctx, tx,
+		psql.Select(billColumns...).
+			From("accounting_split_the_bill").
+			Where(squirrel.Lt{"schema_version": models.CurrentBillSchemaVersion}).
+			Where(squirrel.Gt{"id": afterID}).
+			OrderBy("id").
+			Limit(opts.GetBatchSize()).
+			Suffix("FOR UPDATE"),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(records) == 0 {
+		return 0, 0, nil
+	}
+
+	for _, r := range records {
+		report.Checked++
+
+		bill, err := r.toModel()
+		if err == nil {
+			err = bill.Validate()
+		}
+
+		if err != nil {
+			report.Failed = append(report.Failed, models.BillUpgradeFailure{
+				BillID:        r.ID,
+				SchemaVersion: r.SchemaVersion,
+				Err:           err,
+			})
+			continue
+		}
+
+		if !opts.DryRun {
+			_, err = psql.Update("accounting_split_the_bill").
+				Set("schema_version", models.CurrentBillSchemaVersion).
+				Set("bill", dbBill(bill)).
+				Where(squirrel.Eq{"id": r.ID}).
+				RunWith(tx).
+				ExecContext(ctx)
+			if err != nil {
+				return 0, 0, errors.WithStack(err)
+			}
+		}
+
+		report.Upgraded++
+	}
+
+	if !opts.DryRun {
+		if err := tx.Commit(); err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+	}
+
+	return len(records), records[len(records)-1].ID, nil
+}
@@ -0,0 +1,64 @@
+package pgsql
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBillV1(t *testing.T) {
+	require := require.New(t)
+
+	data := []byte(`{"Items":[{"Title":"beer","PricePerOne":"150","Quantity":2,"Type":0,"Shares":[{"UserID":1,"Share":1}]}],"Payments":[{"UserID":1,"Amount":"300"}]}`)
+	bill, err := decodeBill(1, data)
+	require.NoError(err)
+	require.NoError(bill.Validate())
+	require.True(bill.Items[0].Quantity.Equal(decimal.NewFromInt(2)))
+	require.True(bill.TotalPrice().Equal(decimal.NewFromInt(300)))
+}
+
+func TestDecodeBillRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	bill := models.Bill{
+		QuantityPrecision: 2,
+		Items: []models.BillItem{{
+			Title:       "cheese",
+			PricePerOne: models.Money{Decimal: decimal.RequireFromString("499.90")},
+			Quantity:    decimal.RequireFromString("1.35"),
+			Shares:      []models.BillShare{{UserID: 1, Share: 1}},
+		}},
+	}
+
+	data, err := dbBill(bill).Value()
+	require.NoError(err)
+
+	decoded, err := decodeBill(models.CurrentBillSchemaVersion, []byte(data.(string)))
+	require.NoError(err)
+	require.Equal(int32(2), decoded.QuantityPrecision)
+	require.True(decoded.Items[0].Quantity.Equal(decimal.RequireFromString("1.35")))
+
+	_, err = decodeBill(models.CurrentBillSchemaVersion+1, []byte(data.(string)))
+	require.ErrorIs(err, ErrUnknownBillSchemaVersion)
+}
+
+func TestBillSchemasRegistry(t *testing.T) {
+	for v := 1; v <= models.CurrentBillSchemaVersion; v++ {
+		schema, ok := billSchemas[v]
+		require.True(t, ok, "version %d", v)
+		require.Equal(t, v != models.CurrentBillSchemaVersion, schema.upgrade != nil, "version %d", v)
+	}
+}
+
+func TestUpgradeBillDataV1(t *testing.T) {
+	require := require.New(t)
+
+	data, err := upgradeBillData(1, []byte(`{"Items":[{"Title":"beer","PricePerOne":"150","Quantity":2,"Shares":[]}]}`))
+	require.NoError(err)
+	require.JSONEq(`{"Items":[{"Title":"beer","PricePerOne":"150","Quantity":"2","Shares":[]}]}`, string(data))
+
+	_, err = upgradeBillData(1, []byte(`{"Items":[{"Quantity":"2"}]}`))
+	require.Error(err)
+}
@@ -0,0 +1,107 @@
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Пачка на один INSERT, чтобы не упереться в лимит параметров.
+const exchangeRatesBatchSize = 1000
+
+type dbExchangeRate struct {
+	Base  models.Currency `db:"base"`
+	Quote models.Currency `db:"quote"`
+	Date  time.Time       `db:"rate_date"`
+	Rate  decimal.Decimal `db:"rate"`
+}
+
+// Сохраняет курсы одной транзакцией. Курс на ту же дату перезаписывается.
+// Внутри rates пара на дату не повторяется (см. importer.ParseRates): один
+// INSERT не может обновить строку дважды.
+func (s *Storage) SaveExchangeRates(ctx context.Context, rates []models.ExchangeRate) error {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	for start := 0; start < len(rates); start += exchangeRatesBatchSize {
+		end := start + exchangeRatesBatchSize
+		if end > len(rates) {
+			end = len(rates)
+		}
+
+		q := psql.Insert("exchange_rates").
+			Columns(
+				"base",
+				"quote",
+				"rate_date",
+				"rate",
+			).
+			Suffix(`ON CONFLICT (base, quote, rate_date) DO UPDATE SET rate = EXCLUDED.rate`)
+
+		for _, r := range rates[start:end] {
+			q = q.Values(
+				r.Base.String(),
+				r.Quote.String(),
+				models.RateDate(r.Date),
+				r.Rate,
+			)
+		}
+
+		if _, err := q.RunWith(tx).ExecContext(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+// Последние на дату date курсы между валютами из currencies и DefaultCurrency,
+// не больше одного на пару.
+func (s *Storage) GetExchangeRates(ctx context.Context, currencies []models.Currency, date time.Time) (models.ExchangeRates, error) {
+	codes := []string{models.DefaultCurrency.String()}
+	for _, c := range currencies {
+		codes = append(codes, c.String())
+	}
+
+	query, args, err := psql.Select(
+		"base",
+		"quote",
+		"rate_date",
+		"rate",
+	).
+		Options("DISTINCT ON (base, quote)").
+		From("exchange_rates").
+		Where(squirrel.Eq{"base": codes}).
+		Where(squirrel.Eq{"quote": codes}).
+		Where(squirrel.LtOrEq{"rate_date": models.RateDate(date)}).
+		OrderBy("base", "quote", "rate_date DESC").
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []dbExchangeRate
+	if err := sqlx.SelectContext(ctx, s.pool, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rates := make(models.ExchangeRates, 0, len(records))
+	for _, r := range records {
+		rates = append(rates, models.ExchangeRate{
+			Base:  r.Base,
+			Quote: r.Quote,
+			Date:  r.Date,
+			Rate:  r.Rate,
+		})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,334 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type dbGroup struct {
+	ID        models.GroupID `db:"id"`
+	Title     string         `db:"title"`
+	CreatedBy models.UserID  `db:"created_by"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+type dbGroupMember struct {
+	GroupID  models.GroupID   `db:"group_id"`
+	UserID   models.UserID    `db:"user_id"`
+	Role     models.GroupRole `db:"role"`
+	JoinedAt time.Time        `db:"joined_at"`
+}
+
+// 0 в необязательных ссылках - NULL: group_id у личных счетов и погашений,
+// пустые поля приглашений.
+func nullableID[T ~int64](id T) interface{} {
+	if id == 0 {
+		return nil
+	}
+
+	return id
+}
+
+// Создаёт группу вместе с участниками.
+func (s *Storage) CreateGroup(ctx context.Context, group models.Group) (models.Group, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Group{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if err := checkParticipants(ctx, tx, group.MemberIDs()); err != nil {
+		return models.Group{}, err
+	}
+
+	err = psql.Insert("groups").
+		Columns("title", "created_by").
+		Values(group.Title, group.CreatedBy).
+		Suffix(`RETURNING "id", "created_at"`).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&group.ID, &group.CreatedAt)
+	if err != nil {
+		return models.Group{}, errors.WithStack(err)
+	}
+
+	q := psql.Insert("group_members").
+		Columns("group_id", "user_id", "role").
+		Suffix(`RETURNING "group_id", "user_id", "role", "joined_at"`)
+	for _, m := range group.Members {
+		q = q.Values(group.ID, m.UserID, string(m.Role))
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return models.Group{}, errors.WithStack(err)
+	}
+
+	var members []dbGroupMember
+	if err := sqlx.SelectContext(ctx, tx, &members, query, args...); err != nil {
+		return models.Group{}, errors.WithStack(err)
+	}
+
+	group.Members = groupMembersFromDB(members)
+
+	if err := tx.Commit(); err != nil {
+		return models.Group{}, errors.WithStack(err)
+	}
+
+	return group, nil
+}
+
+func (s *Storage) GetGroup(ctx context.Context, groupID models.GroupID) (models.Group, error) {
+	return selectGroup(ctx, s.pool, groupID)
+}
+
+func selectGroup(ctx context.Context, q sqlx.QueryerContext, groupID models.GroupID) (models.Group, error) {
+	groups, err := selectGroups(ctx, q, squirrel.Eq{"id": groupID})
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	if len(groups) == 0 {
+		return models.Group{}, errors.Wrapf(models.ErrGroupNotFound, "%s", groupID)
+	}
+
+	return groups[0], nil
+}
+
+// Группы, в которых состоит пользователь. От новых к старым.
+func (s *Storage) ListUserGroups(ctx context.Context, userID models.UserID) ([]models.Group, error) {
+	return selectGroups(ctx, s.pool, squirrel.Expr(
+		"id IN (SELECT group_id FROM group_members WHERE user_id = ?)", userID,
+	))
+}
+
+func selectGroups(ctx context.Context, q sqlx.QueryerContext, where squirrel.Sqlizer) ([]models.Group, error) {
+	query, args, err := psql.Select("id", "title", "created_by", "created_at").
+		From("groups").
+		Where(where).
+		OrderBy("id DESC").
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []dbGroup
+	if err := sqlx.SelectContext(ctx, q, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(records) == 0 {
+		return []models.Group{}, nil
+	}
+
+	groupIDs := make([]models.GroupID, 0, len(records))
+	for _, r := range records {
+		groupIDs = append(groupIDs, r.ID)
+	}
+
+	query, args, err = psql.Select("group_id", "user_id", "role", "joined_at").
+		From("group_members").
+		Where(squirrel.Eq{"group_id": groupIDs}).
+		OrderBy("group_id", "user_id").
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var members []dbGroupMember
+	if err := sqlx.SelectContext(ctx, q, &members, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	membersByGroup := map[models.GroupID][]dbGroupMember{}
+	for _, m := range members {
+		membersByGroup[m.GroupID] = append(membersByGroup[m.GroupID], m)
+	}
+
+	groups := make([]models.Group, 0, len(records))
+	for _, r := range records {
+		groups = append(groups, models.Group{
+			ID:        r.ID,
+			Title:     r.Title,
+			CreatedBy: r.CreatedBy,
+			CreatedAt: r.CreatedAt,
+			Members:   groupMembersFromDB(membersByGroup[r.ID]),
+		})
+	}
+
+	return groups, nil
+}
+
+// Участники по возрастанию UserID.
+func groupMembersFromDB(records []dbGroupMember) []models.GroupMember {
+	sort.Slice(records, func(i, j int) bool { return records[i].UserID < records[j].UserID })
+
+	members := make([]models.GroupMember, 0, len(records))
+	for _, m := range records {
+		members = append(members, models.GroupMember{
+			UserID:   m.UserID,
+			Role:     m.Role,
+			JoinedAt: m.JoinedAt,
+		})
+	}
+
+	return members
+}
+
+// Добавляет участника или меняет его роль.
+func (s *Storage) SaveGroupMember(ctx context.Context, groupID models.GroupID, member models.GroupMember) error {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if err := checkParticipants(ctx, tx, []models.UserID{member.UserID}); err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("group_members").
+		Columns("group_id", "user_id", "role").
+		Values(groupID, member.UserID, string(member.Role)).
+		Suffix("ON CONFLICT (group_id, user_id) DO UPDATE SET role = EXCLUDED.role").
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+// Удаляет участника, если check разрешит. Состав группы и балансы для check
+// читаются под блокировкой строки группы, так что новые счета и погашения
+// группы не попадут между проверкой и удалением.
+func (s *Storage) RemoveGroupMember(ctx context.Context, groupID models.GroupID, userID models.UserID, check func(models.Group, models.BalancesByCurrency) error) error {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if err := lockGroup(ctx, tx, groupID, "FOR UPDATE"); err != nil {
+		return err
+	}
+
+	group, err := selectGroup(ctx, tx, groupID)
+	if err != nil {
+		return err
+	}
+
+	balances, err := selectGroupBalances(ctx, tx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := check(group, balances); err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("group_members").
+		Where(squirrel.Eq{"group_id": groupID, "user_id": userID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+// Проверяет участников счёта группы под блокировкой строки группы на чтение:
+// удаление участника ждёт, пока счёт не запишется, и видит его проводки.
+func checkGroupBillMembers(ctx context.Context, tx *sqlx.Tx, bill models.Bill) error {
+	if bill.GroupID == 0 {
+		return nil
+	}
+
+	if err := lockGroup(ctx, tx, bill.GroupID, "FOR SHARE"); err != nil {
+		return err
+	}
+
+	group, err := selectGroup(ctx, tx, bill.GroupID)
+	if err != nil {
+		return err
+	}
+
+	return group.CheckBillMembers(bill)
+}
+
+// Чистые балансы участников по счетам и погашениям группы. Сумма балансов
+// в каждой валюте нулевая.
+func (s *Storage) GetGroupBalances(ctx context.Context, groupID models.GroupID) (models.BalancesByCurrency, error) {
+	return selectGroupBalances(ctx, s.pool, groupID)
+}
+
+func selectGroupBalances(ctx context.Context, q sqlx.QueryerContext, groupID models.GroupID) (models.BalancesByCurrency, error) {
+	query, args, err := psql.
+		Select("currency, user_id, COALESCE(sum(amount), 0)").
+		From("balances").
+		GroupBy("currency, user_id").
+		Prefix(`
+			WITH entries as (
+				SELECT
+					e.currency,
+					e.user_from,
+					e.user_to,
+					e.amount
+				FROM accounting_entries e
+				JOIN owner_objects o ON o.id = e.owning_object_id
+				WHERE o.group_id = ?
+			), balances as (
+				SELECT
+					currency,
+					user_from as user_id,
+					amount
+				FROM entries
+
+				UNION ALL
+
+				SELECT
+					currency,
+					user_to as user_id,
+					- amount
+				FROM entries
+			)`, groupID).
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	return scanBalancesByCurrency(rows)
+}
+
+// Блокирует строку группы до конца транзакции: записи, зависящие от
+// балансов или состава группы, выполняются по очереди.
+func lockGroup(ctx context.Context, tx *sqlx.Tx, groupID models.GroupID, mode string) error {
+	var id models.GroupID
+	err := psql.Select("id").
+		From("groups").
+		Where(squirrel.Eq{"id": groupID}).
+		Suffix(mode).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrapf(models.ErrGroupNotFound, "%s", groupID)
+	}
+
+	return errors.WithStack(err)
+}
@@ -0,0 +1,189 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type dbInvite struct {
+	Code          models.InviteCode `db:"code"`
+	GroupID       models.GroupID    `db:"group_id"`
+	PlaceholderID models.UserID     `db:"placeholder_id"`
+	CreatedBy     models.UserID     `db:"created_by"`
+	CreatedAt     time.Time         `db:"created_at"`
+	ExpiresAt     time.Time         `db:"expires_at"`
+	ClaimedBy     models.UserID     `db:"claimed_by"`
+	ClaimedAt     sql.NullTime      `db:"claimed_at"`
+}
+
+func (r *dbInvite) toModel() models.Invite {
+	return models.Invite{
+		Code:          r.Code,
+		GroupID:       r.GroupID,
+		PlaceholderID: r.PlaceholderID,
+		CreatedBy:     r.CreatedBy,
+		CreatedAt:     r.CreatedAt,
+		ExpiresAt:     r.ExpiresAt,
+		ClaimedBy:     r.ClaimedBy,
+		ClaimedAt:     r.ClaimedAt.Time,
+	}
+}
+
+var inviteColumns = []string{
+	"code",
+	"COALESCE(group_id, 0) AS group_id",
+	"COALESCE(placeholder_id, 0) AS placeholder_id",
+	"created_by",
+	"created_at",
+	"expires_at",
+	"COALESCE(claimed_by, 0) AS claimed_by",
+	"claimed_at",
+}
+
+func (s *Storage) CreateInvite(ctx context.Context, invite models.Invite) (models.Invite, error) {
+	_, err := psql.Insert("user_invites").
+		Columns("code", "group_id", "placeholder_id", "created_by", "created_at", "expires_at").
+		Values(
+			string(invite.Code),
+			nullableID(invite.GroupID),
+			nullableID(invite.PlaceholderID),
+			invite.CreatedBy,
+			invite.CreatedAt,
+			invite.ExpiresAt,
+		).
+		RunWith(s.pool).
+		ExecContext(ctx)
+	if err != nil {
+		return models.Invite{}, errors.WithStack(err)
+	}
+
+	return invite, nil
+}
+
+func selectInvite(ctx context.Context, q sqlx.QueryerContext, sb squirrel.SelectBuilder) (models.Invite, error) {
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return models.Invite{}, errors.WithStack(err)
+	}
+
+	var records []dbInvite
+	if err := sqlx.SelectContext(ctx, q, &records, query, args...); err != nil {
+		return models.Invite{}, errors.WithStack(err)
+	}
+
+	if len(records) == 0 {
+		return models.Invite{}, models.ErrInviteNotFound
+	}
+
+	return records[0].toModel(), nil
+}
+
+func (s *Storage) GetInvite(ctx context.Context, code models.InviteCode) (models.Invite, error) {
+	return selectInvite(ctx, s.pool,
+		psql.Select(inviteColumns...).
+			From("user_invites").
+			Where(squirrel.Eq{"code": string(code)}),
+	)
+}
+
+// Принимает приглашение от имени userID в одной транзакции: счета, проводки,
+// погашения и членство в группах заглушки переходят к userID, а сам он
+// становится участником группы приглашения.
+func (s *Storage) ClaimInvite(ctx context.Context, code models.InviteCode, userID models.UserID, now time.Time) (models.Invite, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Invite{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	invite, err := selectInvite(ctx, tx,
+		psql.Select(inviteColumns...).
+			From("user_invites").
+			Where(squirrel.Eq{"code": string(code)}).
+			Suffix("FOR UPDATE"),
+	)
+	if err != nil {
+		return models.Invite{}, err
+	}
+
+	if err := invite.CheckClaimable(userID, now); err != nil {
+		return models.Invite{}, err
+	}
+
+	if err := checkParticipants(ctx, tx, []models.UserID{userID}); err != nil {
+		return models.Invite{}, err
+	}
+
+	if invite.PlaceholderID != 0 {
+		placeholder, err := selectUser(ctx, tx,
+			psql.Select(userColumns...).
+				From("users").
+				Where(squirrel.Eq{"id": invite.PlaceholderID}).
+				Suffix("FOR UPDATE"),
+		)
+		if err != nil {
+			return models.Invite{}, errors.Wrapf(err, "%s", invite.PlaceholderID)
+		}
+
+		if !placeholder.Placeholder {
+			return models.Invite{}, errors.Wrapf(models.ErrNotPlaceholder, "%s", placeholder.ID)
+		}
+
+		if placeholder.ClaimedBy != 0 {
+			return models.Invite{}, errors.Wrapf(models.ErrPlaceholderClaimed, "%s by %s", placeholder.ID, placeholder.ClaimedBy)
+		}
+
+		if err := placeholder.CheckActive(); err != nil {
+			return models.Invite{}, err
+		}
+
+		if _, err := reassignUser(ctx, tx, placeholder.ID, userID); err != nil {
+			return models.Invite{}, err
+		}
+
+		_, err = psql.Update("users").
+			Set("claimed_by", userID).
+			Where(squirrel.Eq{"id": placeholder.ID}).
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			return models.Invite{}, errors.WithStack(err)
+		}
+	}
+
+	if invite.GroupID != 0 {
+		_, err := psql.Insert("group_members").
+			Columns("group_id", "user_id", "role").
+			Values(invite.GroupID, userID, string(models.GroupRoleMember)).
+			Suffix("ON CONFLICT (group_id, user_id) DO NOTHING").
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			return models.Invite{}, errors.WithStack(err)
+		}
+	}
+
+	invite.ClaimedBy = userID
+	invite.ClaimedAt = now
+	_, err = psql.Update("user_invites").
+		Set("claimed_by", invite.ClaimedBy).
+		Set("claimed_at", invite.ClaimedAt).
+		Where(squirrel.Eq{"code": string(code)}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return models.Invite{}, errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Invite{}, errors.WithStack(err)
+	}
+
+	return invite, nil
+}
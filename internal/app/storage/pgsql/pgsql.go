@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/stdlib"
 )
 
@@ -36,6 +38,11 @@ func (s *Storage) Close(context.Context) error {
 	return s.pool.Close()
 }
 
+// Проверка соединения с базой.
+func (s *Storage) Ping(ctx context.Context) error {
+	return errors.WithStack(s.pool.PingContext(ctx))
+}
+
 func GetDB(uri string) (*sqlx.DB, error) {
 	// before : directly using sqlx
 	// DB, err = sqlx.Connect("postgres", uri)
@@ -71,6 +78,88 @@ func PgxCreateDB(uri string) (*sqlx.DB, error) {
 	return sqlx.NewDb(pgxdb, "pgx"), nil
 }
 
+// Нарушение уникального индекса, например занятое имя пользователя.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// Вид владеющего объекта: чем порождены проводки.
+const (
+	ownerObjectKindSplitTheBill = "split_the_bill"
+	ownerObjectKindSettlement   = "settlement"
+)
+
+// Значение created_at для INSERT: нулевое время - now() на стороне базы.
+func createdAtOrNow(t time.Time) any {
+	if t.IsZero() {
+		return squirrel.Expr("now()")
+	}
+
+	return t
+}
+
+func insertOwnerObject(ctx context.Context, tx *sqlx.Tx, ownerID models.UserID, kind string, groupID models.GroupID, createdAt time.Time) (int64, error) {
+	var owningObjID int64
+	err := psql.Insert("owner_objects").
+		Columns(
+			"user_id",
+			"kind",
+			"group_id",
+			"created_at",
+		).
+		Values(
+			ownerID,
+			kind,
+			nullableID(groupID),
+			createdAtOrNow(createdAt),
+		).
+		Suffix(`RETURNING "id"`).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&owningObjID)
+
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return owningObjID, nil
+}
+
+func insertAccountingEntries(ctx context.Context, tx *sqlx.Tx, ownerID models.UserID, owningObjID int64, invoices []models.Invoice, createdAt time.Time) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	q := psql.Insert("accounting_entries").
+		Columns(
+			"user_id",
+			"owning_object_id",
+			"user_from",
+			"user_to",
+			"amount",
+			"currency",
+			"created_at",
+		)
+
+	for _, invoice := range invoices {
+		q = q.Values(
+			ownerID,
+			owningObjID,
+			invoice.UserFrom,
+			invoice.UserTo,
+			invoice.Value.Decimal,
+			invoice.Currency.String(),
+			createdAtOrNow(createdAt),
+		)
+	}
+
+	_, err := q.RunWith(tx).ExecContext(ctx)
+
+	return errors.WithStack(err)
+}
+
 func scanToMap[K comparable, V any](rows *sql.Rows) (map[K]V, error) {
 	result := make(map[K]V)
 
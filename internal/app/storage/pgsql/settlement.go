@@ -0,0 +1,174 @@
+package pgsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type dbSettlement struct {
+	ID         models.SettlementID `db:"id"`
+	RecordedBy models.UserID       `db:"user_id"`
+	PayerID    models.UserID       `db:"payer_id"`
+	PayeeID    models.UserID       `db:"payee_id"`
+	Amount     models.Money        `db:"amount"`
+	Currency   models.Currency     `db:"currency"`
+	CreatedAt  time.Time           `db:"created_at"`
+	GroupID    models.GroupID      `db:"group_id"`
+}
+
+// Записывает погашение. Балансы для check читаются в той же транзакции под
+// блокировкой: пары payer/payee для личных погашений и строки группы для
+// групповых. Так параллельные погашения не переплатят долг. check возвращает
+// итоговую запись или ошибку, которая прерывает транзакцию.
+func (s *Storage) SaveSettlement(ctx context.Context, settlement models.Settlement, check func(models.BalancesByCurrency) (models.Settlement, error)) (models.Settlement, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Settlement{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if err := checkCounterparties(ctx, tx, []models.UserID{settlement.PayerID, settlement.PayeeID}); err != nil {
+		return models.Settlement{}, err
+	}
+
+	var balances models.BalancesByCurrency
+	if settlement.GroupID != 0 {
+		if err := lockGroup(ctx, tx, settlement.GroupID, "FOR UPDATE"); err != nil {
+			return models.Settlement{}, err
+		}
+
+		balances, err = selectGroupBalances(ctx, tx, settlement.GroupID)
+	} else {
+		if err := lockUserPair(ctx, tx, settlement.PayerID, settlement.PayeeID); err != nil {
+			return models.Settlement{}, err
+		}
+
+		balances, err = selectUserBalances(ctx, tx, settlement.PayerID)
+	}
+	if err != nil {
+		return models.Settlement{}, err
+	}
+
+	if settlement, err = check(balances); err != nil {
+		return models.Settlement{}, err
+	}
+
+	owningObjID, err := insertOwnerObject(ctx, tx, settlement.RecordedBy, ownerObjectKindSettlement, settlement.GroupID, time.Time{})
+	if err != nil {
+		return models.Settlement{}, err
+	}
+
+	err = psql.Insert("accounting_settlements").
+		Columns(
+			"user_id",
+			"owning_object_id",
+			"payer_id",
+			"payee_id",
+			"amount",
+			"currency",
+		).
+		Values(
+			settlement.RecordedBy,
+			owningObjID,
+			settlement.PayerID,
+			settlement.PayeeID,
+			settlement.Amount.Decimal,
+			settlement.Currency.String(),
+		).
+		Suffix(`RETURNING "id", "created_at"`).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&settlement.ID, &settlement.CreatedAt)
+	if err != nil {
+		return models.Settlement{}, errors.WithStack(err)
+	}
+
+	if err := insertAccountingEntries(ctx, tx, settlement.RecordedBy, owningObjID, []models.Invoice{settlement.ToInvoice()}, time.Time{}); err != nil {
+		return models.Settlement{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Settlement{}, errors.WithStack(err)
+	}
+
+	return settlement, nil
+}
+
+// Сериализует погашения между двумя пользователями до конца транзакции.
+// Ключ не зависит от направления перевода.
+func lockUserPair(ctx context.Context, tx *sqlx.Tx, a, b models.UserID) error {
+	if a > b {
+		a, b = b, a
+	}
+
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", fmt.Sprintf("settlement:%d:%d", a, b))
+
+	return errors.WithStack(err)
+}
+
+// История погашений, где пользователь платил или получал. От новых к старым.
+func (s *Storage) ListSettlements(ctx context.Context, userID models.UserID, filter models.SettlementListFilter) ([]models.Settlement, error) {
+	q := psql.Select(
+		"id",
+		"user_id",
+		"payer_id",
+		"payee_id",
+		"amount",
+		"currency",
+		"created_at",
+		"COALESCE((SELECT group_id FROM owner_objects o WHERE o.id = owning_object_id), 0) AS group_id",
+	).
+		From("accounting_settlements").
+		Where(squirrel.Or{
+			squirrel.Eq{"payer_id": userID},
+			squirrel.Eq{"payee_id": userID},
+		}).
+		OrderBy("id DESC")
+
+	if filter.CounterpartyID != 0 {
+		q = q.Where(squirrel.Or{
+			squirrel.Eq{"payer_id": filter.CounterpartyID},
+			squirrel.Eq{"payee_id": filter.CounterpartyID},
+		})
+	}
+
+	if filter.BeforeID != 0 {
+		q = q.Where(squirrel.Lt{"id": filter.BeforeID})
+	}
+
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []dbSettlement
+	if err := sqlx.SelectContext(ctx, s.pool, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	settlements := make([]models.Settlement, 0, len(records))
+	for _, r := range records {
+		settlements = append(settlements, models.Settlement{
+			ID:         r.ID,
+			RecordedBy: r.RecordedBy,
+			PayerID:    r.PayerID,
+			PayeeID:    r.PayeeID,
+			Amount:     r.Amount,
+			Currency:   r.Currency,
+			CreatedAt:  r.CreatedAt,
+			GroupID:    r.GroupID,
+		})
+	}
+
+	return settlements, nil
+}
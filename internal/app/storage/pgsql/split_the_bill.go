@@ -4,11 +4,19 @@ import (
 	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"sort"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
+var (
+	ErrUnknownBillSchemaVersion = errors.New("unknown bill schema version")
+)
+
 type dbBill models.Bill
 
 // Value make the Attrs struct implement the driver.Valuer interface.
@@ -30,105 +38,543 @@ func (b *dbBill) Scan(value interface{}) error {
 }
 
 type dbInvoice struct {
-	Accounting int64         `db:"owner_accounting"`
-	UserFrom   models.UserID `db:"user_from"`
-	UserTo     models.UserID `db:"user_to"`
-	Amount     models.Money  `db:"amount"`
+	Accounting int64           `db:"owner_accounting"`
+	UserFrom   models.UserID   `db:"user_from"`
+	UserTo     models.UserID   `db:"user_to"`
+	Amount     models.Money    `db:"amount"`
+	Currency   models.Currency `db:"currency"`
 }
 
 func (s *Storage) SaveSplittedBill(ctx context.Context, ownerID models.UserID, bill models.Bill) (models.BillID, error) {
-	invoices, err := bill.ToInvoices()
+	tx, err := s.pool.BeginTxx(ctx, nil)
 	if err != nil {
 		return 0, errors.WithStack(err)
 	}
+	defer tx.Rollback()
 
-	tx, err := s.pool.BeginTxx(ctx, nil)
+	billID, err := saveSplittedBill(ctx, tx, ownerID, bill, time.Time{})
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return 0, errors.WithStack(err)
 	}
-	defer tx.Rollback()
 
-	var owningObjID int64
-	psql.Insert("owner_objects").
-		Columns(
-			"user_id",
-		).
-		Values(
-			ownerID,
-		).
-		Suffix(`RETURNING "id"`).
-		RunWith(tx).
-		QueryRow().
-		Scan(&owningObjID)
+	return billID, nil
+}
+
+// Новый счёт с первой ревизией и проводками. Нулевой createdAt - сейчас.
+func saveSplittedBill(ctx context.Context, tx *sqlx.Tx, ownerID models.UserID, bill models.Bill, createdAt time.Time) (models.BillID, error) {
+	// От владельца зависит округление.
+	bill.OwnerID = ownerID
 
+	invoices, err := bill.ToInvoices()
 	if err != nil {
 		return 0, errors.WithStack(err)
 	}
 
+	if err := checkGroupBillMembers(ctx, tx, bill); err != nil {
+		return 0, err
+	}
+
+	if err := checkParticipants(ctx, tx, participantIDs(bill, models.Bill{})); err != nil {
+		return 0, err
+	}
+
+	owningObjID, err := insertOwnerObject(ctx, tx, ownerID, ownerObjectKindSplitTheBill, bill.GroupID, createdAt)
+	if err != nil {
+		return 0, err
+	}
+
 	var billID models.BillID
-	psql.Insert("accounting_split_the_bill").
+	err = psql.Insert("accounting_split_the_bill").
 		Columns(
 			"user_id",
 			"owning_object_id",
 			"schema_version",
 			"bill",
+			"created_at",
 		).
 		Values(
 			ownerID,
 			owningObjID,
 			bill.GetSchemaVersion(),
 			dbBill(bill),
+			createdAtOrNow(createdAt),
 		).
 		Suffix(`RETURNING "id"`).
 		RunWith(tx).
-		QueryRow().
+		QueryRowContext(ctx).
 		Scan(&billID)
 
 	if err != nil {
 		return 0, errors.WithStack(err)
 	}
 
-	q := psql.Insert("accounting_entries").
-		Columns(
-			"user_id",
-			"owning_object_id",
-			"user_from",
-			"user_to",
-			"amount",
-		)
+	if err := insertBillRevision(ctx, tx, billID, 1, ownerID, bill); err != nil {
+		return 0, err
+	}
 
-	for _, invoice := range invoices {
-		q = q.Values(
-			ownerID,
-			owningObjID,
-			invoice.UserFrom,
-			invoice.UserTo,
-			invoice.Value.Decimal,
-		)
+	if err := insertAccountingEntries(ctx, tx, ownerID, owningObjID, invoices, createdAt); err != nil {
+		return 0, err
 	}
 
-	rows, err := q.RunWith(tx).Query()
+	return billID, nil
+}
+
+// Участники bill, которых нет в old, по возрастанию.
+func participantIDs(bill, old models.Bill) []models.UserID {
+	oldParticipants := old.Participants()
+
+	res := []models.UserID{}
+	for userID := range bill.Participants() {
+		if _, ok := oldParticipants[userID]; !ok {
+			res = append(res, userID)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res
+}
+
+// Строка таблицы accounting_split_the_bill. Сам счёт хранится как есть,
+// т.к. его формат зависит от schema_version.
+type dbBillRecord struct {
+	ID             models.BillID  `db:"id"`
+	UserID         models.UserID  `db:"user_id"`
+	OwningObjectID int64          `db:"owning_object_id"`
+	SchemaVersion  int            `db:"schema_version"`
+	Revision       int            `db:"revision"`
+	GroupID        models.GroupID `db:"group_id"`
+	Bill           []byte         `db:"bill"`
+	CreatedAt      time.Time      `db:"created_at"`
+}
+
+func (r *dbBillRecord) toModel() (models.Bill, error) {
+	bill, err := decodeBill(r.SchemaVersion, r.Bill)
 	if err != nil {
-		return 0, errors.WithStack(err)
+		return models.Bill{}, errors.Wrapf(err, "fail decode %s", r.ID)
 	}
-	defer rows.Close()
 
-	err = tx.Commit()
+	bill.ID = r.ID
+	bill.OwnerID = r.UserID
+	bill.CreatedAt = r.CreatedAt
+	bill.Revision = r.Revision
+	bill.GroupID = r.GroupID
+
+	return bill, nil
+}
+
+var billColumns = []string{
+	"id",
+	"user_id",
+	"owning_object_id",
+	"schema_version",
+	"revision",
+	"bill",
+	"created_at",
+	"COALESCE((SELECT group_id FROM owner_objects o WHERE o.id = owning_object_id), 0) AS group_id",
+}
+
+func selectBillRecords(ctx context.Context, q sqlx.QueryerContext, sb squirrel.SelectBuilder) ([]dbBillRecord, error) {
+	query, args, err := sb.ToSql()
 	if err != nil {
-		return 0, errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
-	return billID, nil
+	var records []dbBillRecord
+	if err := sqlx.SelectContext(ctx, q, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return records, nil
 }
 
-func (s *Storage) ListUserBills(ctx context.Context, userID models.UserID) ([]models.Bill, error) {
-	return nil, nil
+func billsFromRecords(records []dbBillRecord) ([]models.Bill, error) {
+	bills := make([]models.Bill, 0, len(records))
+	for _, r := range records {
+		bill, err := r.toModel()
+		if err != nil {
+			return nil, err
+		}
+
+		bills = append(bills, bill)
+	}
+
+	return bills, nil
+}
+
+// Упорядочивает записи в порядке запрошенных идентификаторов.
+// Если хотя бы одного счёта нет - ErrBillNotFound.
+func orderBillRecords(records []dbBillRecord, billIDs []models.BillID) ([]dbBillRecord, error) {
+	byID := make(map[models.BillID]dbBillRecord, len(records))
+	for _, r := range records {
+		byID[r.ID] = r
+	}
+
+	ordered := make([]dbBillRecord, 0, len(billIDs))
+	for _, billID := range billIDs {
+		r, ok := byID[billID]
+		if !ok {
+			return nil, errors.Wrapf(models.ErrBillNotFound, "%s", billID)
+		}
+
+		ordered = append(ordered, r)
+	}
+
+	return ordered, nil
+}
+
+// Участник счёта - тот, кто есть в долях позиций, надбавках или в оплатах,
+// как в Bill.Participants.
+func billParticipantExpr(userID models.UserID) squirrel.Sqlizer {
+	return squirrel.Expr(`(
+		jsonb_path_exists(bill, '$.Items[*].Shares[*].UserID ?? (@ == $uid)', jsonb_build_object('uid', ?::bigint))
+		OR jsonb_path_exists(bill, '$.Adjustments[*].UserIDs[*] ?? (@ == $uid)', jsonb_build_object('uid', ?::bigint))
+		OR jsonb_path_exists(bill, '$.Payments[*].UserID ?? (@ == $uid)', jsonb_build_object('uid', ?::bigint))
+	)`, userID, userID, userID)
+}
+
+// Счета, которые пользователь создал или по которым у него есть проводки.
+// С filter.GroupID - все счета группы, членство проверяет вызывающий.
+// Отсортированы от новых к старым, с filter.OldestFirst - наоборот.
+func (s *Storage) ListUserBills(ctx context.Context, userID models.UserID, filter models.BillListFilter) ([]models.Bill, error) {
+	q := psql.Select(billColumns...).
+		From("accounting_split_the_bill")
+
+	if filter.OldestFirst {
+		q = q.OrderBy("id")
+	} else {
+		q = q.OrderBy("id DESC")
+	}
+
+	if filter.GroupID != 0 {
+		q = q.Where(squirrel.Expr(`owning_object_id IN (
+			SELECT id
+			FROM owner_objects
+			WHERE group_id = ?
+		)`, filter.GroupID))
+	} else {
+		q = q.Where(squirrel.Or{
+			squirrel.Eq{"user_id": userID},
+			squirrel.Expr(`owning_object_id IN (
+				SELECT owning_object_id
+				FROM accounting_entries
+				WHERE user_from = ? OR user_to = ?
+			)`, userID, userID),
+		})
+	}
+
+	if filter.ParticipantID != 0 {
+		q = q.Where(billParticipantExpr(filter.ParticipantID))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"created_at": filter.CreatedFrom})
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		q = q.Where(squirrel.Lt{"created_at": filter.CreatedTo})
+	}
+
+	if filter.BeforeID != 0 {
+		q = q.Where(squirrel.Lt{"id": filter.BeforeID})
+	}
+
+	if filter.AfterID != 0 {
+		q = q.Where(squirrel.Gt{"id": filter.AfterID})
+	}
+
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	records, err := selectBillRecords(ctx, s.pool, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return billsFromRecords(records)
 }
 
 func (s *Storage) GetBills(ctx context.Context, billIDs []models.BillID) ([]models.Bill, error) {
-	return nil, nil
+	if len(billIDs) == 0 {
+		return []models.Bill{}, nil
+	}
+
+	records, err := selectBillRecords(ctx, s.pool,
+		psql.Select(billColumns...).
+			From("accounting_split_the_bill").
+			Where(squirrel.Eq{"id": billIDs}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err = orderBillRecords(records, billIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return billsFromRecords(records)
 }
 
+// Удаляет owner_objects счетов, каскадом уходят и сами счета, и их проводки.
+// Возвращает удалённые счета.
 func (s *Storage) DeleteBills(ctx context.Context, billIDs []models.BillID) ([]models.Bill, error) {
-	return nil, nil
+	if len(billIDs) == 0 {
+		return []models.Bill{}, nil
+	}
+
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	records, err := selectBillRecords(ctx, tx,
+		psql.Select(billColumns...).
+			From("accounting_split_the_bill").
+			Where(squirrel.Eq{"id": billIDs}).
+			Suffix("FOR UPDATE"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err = orderBillRecords(records, billIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	bills, err := billsFromRecords(records)
+	if err != nil {
+		return nil, err
+	}
+
+	owningObjIDs := make([]int64, 0, len(records))
+	for _, r := range records {
+		owningObjIDs = append(owningObjIDs, r.OwningObjectID)
+	}
+
+	_, err = psql.Delete("owner_objects").
+		Where(squirrel.Eq{"id": owningObjIDs}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return bills, nil
+}
+
+func insertBillRevision(ctx context.Context, tx *sqlx.Tx, billID models.BillID, revision int, userID models.UserID, bill models.Bill) error {
+	_, err := psql.Insert("accounting_split_the_bill_revisions").
+		Columns(
+			"bill_id",
+			"revision",
+			"user_id",
+			"schema_version",
+			"bill",
+		).
+		Values(
+			billID,
+			revision,
+			userID,
+			bill.GetSchemaVersion(),
+			dbBill(bill),
+		).
+		RunWith(tx).
+		ExecContext(ctx)
+
+	return errors.WithStack(err)
+}
+
+// Сохраняет новую ревизию счёта. Старые проводки счёта сторнируются
+// встречными, новые проводятся в той же транзакции. Если ревизия в базе
+// уже не revision - ErrRevisionConflict.
+func (s *Storage) UpdateSplittedBill(ctx context.Context, editorID models.UserID, billID models.BillID, revision int, bill models.Bill) (models.Bill, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Bill{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	records, err := selectBillRecords(ctx, tx,
+		psql.Select(billColumns...).
+			From("accounting_split_the_bill").
+			Where(squirrel.Eq{"id": billID}).
+			Suffix("FOR UPDATE"),
+	)
+	if err != nil {
+		return models.Bill{}, err
+	}
+
+	if len(records) == 0 {
+		return models.Bill{}, errors.Wrapf(models.ErrBillNotFound, "%s", billID)
+	}
+
+	record := records[0]
+	if record.Revision != revision {
+		return models.Bill{}, errors.Wrapf(models.ErrRevisionConflict, "%s: revision %d, expected %d", billID, record.Revision, revision)
+	}
+
+	// Владелец, группа и время создания не меняются.
+	bill.ID = record.ID
+	bill.OwnerID = record.UserID
+	bill.CreatedAt = record.CreatedAt
+	bill.GroupID = record.GroupID
+	bill.Revision = record.Revision + 1
+
+	invoices, err := bill.ToInvoices()
+	if err != nil {
+		return models.Bill{}, errors.WithStack(err)
+	}
+
+	if err := checkGroupBillMembers(ctx, tx, bill); err != nil {
+		return models.Bill{}, err
+	}
+
+	old, err := record.toModel()
+	if err != nil {
+		return models.Bill{}, err
+	}
+
+	if err := checkParticipants(ctx, tx, participantIDs(bill, old)); err != nil {
+		return models.Bill{}, err
+	}
+
+	posted, err := selectObjectInvoices(ctx, tx, record.OwningObjectID)
+	if err != nil {
+		return models.Bill{}, err
+	}
+
+	_, err = psql.Update("accounting_split_the_bill").
+		Set("schema_version", bill.GetSchemaVersion()).
+		Set("revision", bill.Revision).
+		Set("bill", dbBill(bill)).
+		Where(squirrel.Eq{"id": billID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return models.Bill{}, errors.WithStack(err)
+	}
+
+	if err := insertBillRevision(ctx, tx, billID, bill.Revision, editorID, bill); err != nil {
+		return models.Bill{}, err
+	}
+
+	reversal := models.ReverseInvoices(posted)
+	if err := insertAccountingEntries(ctx, tx, record.UserID, record.OwningObjectID, reversal, time.Time{}); err != nil {
+		return models.Bill{}, err
+	}
+
+	if err := insertAccountingEntries(ctx, tx, record.UserID, record.OwningObjectID, invoices, time.Time{}); err != nil {
+		return models.Bill{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Bill{}, errors.WithStack(err)
+	}
+
+	return bill, nil
+}
+
+// Все проводки объекта учёта, включая сторно.
+func selectObjectInvoices(ctx context.Context, q sqlx.QueryerContext, owningObjID int64) ([]models.Invoice, error) {
+	query, args, err := psql.Select("user_from", "user_to", "amount", "currency").
+		From("accounting_entries").
+		Where(squirrel.Eq{"owning_object_id": owningObjID}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var entries []dbInvoice
+	if err := sqlx.SelectContext(ctx, q, &entries, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	invoices := make([]models.Invoice, 0, len(entries))
+	for _, e := range entries {
+		invoices = append(invoices, models.Invoice{
+			UserFrom: e.UserFrom,
+			UserTo:   e.UserTo,
+			Value:    e.Amount,
+			Currency: e.Currency,
+		})
+	}
+
+	return invoices, nil
+}
+
+// Все версии счёта по порядку.
+func (s *Storage) GetBillRevisions(ctx context.Context, billID models.BillID) ([]models.BillRevision, error) {
+	query, args, err := psql.Select(
+		"r.revision",
+		"r.user_id",
+		"r.schema_version",
+		"r.bill",
+		"r.created_at",
+		"b.user_id AS owner_id",
+		"b.created_at AS bill_created_at",
+		"COALESCE(o.group_id, 0) AS group_id",
+	).
+		From("accounting_split_the_bill_revisions r").
+		Join("accounting_split_the_bill b ON b.id = r.bill_id").
+		Join("owner_objects o ON o.id = b.owning_object_id").
+		Where(squirrel.Eq{"r.bill_id": billID}).
+		OrderBy("r.revision").
+		ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []struct {
+		Revision      int            `db:"revision"`
+		UserID        models.UserID  `db:"user_id"`
+		SchemaVersion int            `db:"schema_version"`
+		Bill          []byte         `db:"bill"`
+		CreatedAt     time.Time      `db:"created_at"`
+		OwnerID       models.UserID  `db:"owner_id"`
+		BillCreatedAt time.Time      `db:"bill_created_at"`
+		GroupID       models.GroupID `db:"group_id"`
+	}
+	if err := sqlx.SelectContext(ctx, s.pool, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(records) == 0 {
+		return nil, errors.Wrapf(models.ErrBillNotFound, "%s", billID)
+	}
+
+	revisions := make([]models.BillRevision, 0, len(records))
+	for _, r := range records {
+		bill, err := decodeBill(r.SchemaVersion, r.Bill)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fail decode %s revision %d", billID, r.Revision)
+		}
+
+		bill.ID = billID
+		bill.OwnerID = r.OwnerID
+		bill.CreatedAt = r.BillCreatedAt
+		bill.Revision = r.Revision
+		bill.GroupID = r.GroupID
+
+		revisions = append(revisions, models.BillRevision{
+			Revision:  r.Revision,
+			EditorID:  r.UserID,
+			CreatedAt: r.CreatedAt,
+			Bill:      bill,
+		})
+	}
+
+	return revisions, nil
 }
@@ -0,0 +1,105 @@
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type dbStatementEntry struct {
+	EntryID        int64                  `db:"id"`
+	CreatedAt      time.Time              `db:"created_at"`
+	Source         models.StatementSource `db:"kind"`
+	BillID         models.BillID          `db:"bill_id"`
+	SettlementID   models.SettlementID    `db:"settlement_id"`
+	GroupID        models.GroupID         `db:"group_id"`
+	CounterpartyID models.UserID          `db:"counterparty_id"`
+	Currency       models.Currency        `db:"currency"`
+	Amount         models.Money           `db:"amount"`
+	Balance        models.Money           `db:"balance"`
+}
+
+// Выписка по проводкам пользователя от старых к новым. Баланс считается
+// нарастающим итогом по валюте до фильтра по датам и курсору, поэтому
+// на любой странице он совпадает с балансом на момент проводки.
+func (s *Storage) GetStatement(ctx context.Context, userID models.UserID, filter models.StatementFilter) ([]models.StatementEntry, error) {
+	query, args, err := statementQuery(userID, filter).ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []dbStatementEntry
+	if err := sqlx.SelectContext(ctx, s.pool, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res := make([]models.StatementEntry, 0, len(records))
+	for _, r := range records {
+		res = append(res, models.StatementEntry(r))
+	}
+
+	return res, nil
+}
+
+func statementQuery(userID models.UserID, filter models.StatementFilter) squirrel.SelectBuilder {
+	entries := psql.Select(
+		"e.id",
+		"e.created_at",
+		"o.kind",
+		"COALESCE(b.id, 0) AS bill_id",
+		"COALESCE(st.id, 0) AS settlement_id",
+		"COALESCE(o.group_id, 0) AS group_id",
+		"e.currency",
+	).
+		Column("CASE WHEN e.user_from = ? THEN e.user_to ELSE e.user_from END AS counterparty_id", userID).
+		Column("CASE WHEN e.user_from = ? THEN e.amount ELSE -e.amount END AS amount", userID).
+		From("accounting_entries e").
+		Join("owner_objects o ON o.id = e.owning_object_id").
+		LeftJoin("accounting_split_the_bill b ON b.owning_object_id = o.id").
+		LeftJoin("accounting_settlements st ON st.owning_object_id = o.id").
+		Where(squirrel.Or{
+			squirrel.Eq{"e.user_from": userID},
+			squirrel.Eq{"e.user_to": userID},
+		})
+
+	if filter.CounterpartyID != 0 {
+		entries = entries.Where(squirrel.Or{
+			squirrel.Eq{"e.user_from": filter.CounterpartyID},
+			squirrel.Eq{"e.user_to": filter.CounterpartyID},
+		})
+	}
+
+	if filter.GroupID != 0 {
+		entries = entries.Where(squirrel.Eq{"o.group_id": filter.GroupID})
+	}
+
+	withBalance := psql.Select("*").
+		Column("sum(amount) OVER (PARTITION BY currency ORDER BY created_at, id) AS balance").
+		FromSelect(entries, "entries")
+
+	q := psql.Select("*").
+		FromSelect(withBalance, "statement").
+		OrderBy("created_at", "id")
+
+	if !filter.From.IsZero() {
+		q = q.Where(squirrel.GtOrEq{"created_at": filter.From})
+	}
+
+	if !filter.To.IsZero() {
+		q = q.Where(squirrel.Lt{"created_at": filter.To})
+	}
+
+	if filter.AfterEntryID != 0 {
+		q = q.Where("(created_at, id) > (SELECT created_at, id FROM accounting_entries WHERE id = ?)", filter.AfterEntryID)
+	}
+
+	if filter.Limit != 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	return q
+}
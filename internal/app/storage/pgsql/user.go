@@ -2,8 +2,13 @@ package pgsql
 
 import (
 	"context"
+	"database/sql"
+	"strings"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 )
 
@@ -23,3 +28,349 @@ func (s *Storage) CreateUser(ctx context.Context, title string) (models.UserID,
 
 	return userID, nil
 }
+
+type dbUser struct {
+	ID          models.UserID `db:"id"`
+	Title       string        `db:"title"`
+	Placeholder bool          `db:"placeholder"`
+	CreatedBy   models.UserID `db:"created_by"`
+	ClaimedBy   models.UserID `db:"claimed_by"`
+	MergedInto  models.UserID `db:"merged_into"`
+
+	ExternalProvider string       `db:"external_provider"`
+	ExternalSubject  string       `db:"external_subject"`
+	CreatedAt        time.Time    `db:"created_at"`
+	DeactivatedAt    sql.NullTime `db:"deactivated_at"`
+}
+
+func (r *dbUser) toModel() models.User {
+	return models.User{
+		ID:          r.ID,
+		Title:       r.Title,
+		Placeholder: r.Placeholder,
+		CreatedBy:   r.CreatedBy,
+		ClaimedBy:   r.ClaimedBy,
+		MergedInto:  r.MergedInto,
+		External: models.ExternalIdentity{
+			Provider: r.ExternalProvider,
+			Subject:  r.ExternalSubject,
+		},
+		CreatedAt:     r.CreatedAt,
+		DeactivatedAt: r.DeactivatedAt.Time,
+	}
+}
+
+var userColumns = []string{
+	"id",
+	"title",
+	"placeholder",
+	"COALESCE(created_by, 0) AS created_by",
+	"COALESCE(claimed_by, 0) AS claimed_by",
+	"COALESCE(merged_into, 0) AS merged_into",
+	"COALESCE(external_provider, '') AS external_provider",
+	"COALESCE(external_subject, '') AS external_subject",
+	"created_at",
+	"deactivated_at",
+}
+
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+func selectUsers(ctx context.Context, q sqlx.QueryerContext, sb squirrel.SelectBuilder) ([]models.User, error) {
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var records []dbUser
+	if err := sqlx.SelectContext(ctx, q, &records, query, args...); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	users := make([]models.User, 0, len(records))
+	for i := range records {
+		users = append(users, records[i].toModel())
+	}
+
+	return users, nil
+}
+
+func selectUser(ctx context.Context, q sqlx.QueryerContext, sb squirrel.SelectBuilder) (models.User, error) {
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	var records []dbUser
+	if err := sqlx.SelectContext(ctx, q, &records, query, args...); err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	if len(records) == 0 {
+		return models.User{}, models.ErrUserNotFound
+	}
+
+	return records[0].toModel(), nil
+}
+
+func lockUser(ctx context.Context, tx *sqlx.Tx, userID models.UserID) (models.User, error) {
+	user, err := selectUser(ctx, tx,
+		psql.Select(userColumns...).
+			From("users").
+			Where(squirrel.Eq{"id": userID}).
+			Suffix("FOR UPDATE"),
+	)
+
+	return user, errors.Wrapf(err, "%s", userID)
+}
+
+// Проверяет участников новых счетов, групп и приглашений: слитых и
+// деактивированных указывать нельзя.
+func checkParticipants(ctx context.Context, tx *sqlx.Tx, userIDs []models.UserID) error {
+	return checkUsers(ctx, tx, userIDs, (*models.User).CheckActive)
+}
+
+// Проверяет стороны погашения. Долг с деактивированным пользователем
+// закрыть можно, со слитым дублем - нет: долг уже у того, в кого слили.
+func checkCounterparties(ctx context.Context, tx *sqlx.Tx, userIDs []models.UserID) error {
+	return checkUsers(ctx, tx, userIDs, (*models.User).CheckNotMerged)
+}
+
+// Блокирует пользователей на чтение до конца транзакции и проверяет каждого
+// через check. Слияние и деактивация ждут такую транзакцию, а слияние
+// переносит уже её записи.
+func checkUsers(ctx context.Context, tx *sqlx.Tx, userIDs []models.UserID, check func(*models.User) error) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	users, err := selectUsers(ctx, tx,
+		psql.Select(userColumns...).
+			From("users").
+			Where(squirrel.Eq{"id": userIDs}).
+			OrderBy("id").
+			Suffix("FOR SHARE"),
+	)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[models.UserID]models.User, len(users))
+	for _, user := range users {
+		byID[user.ID] = user
+	}
+
+	for _, userID := range userIDs {
+		user, ok := byID[userID]
+		if !ok {
+			return errors.Wrapf(models.ErrUserNotFound, "%s", userID)
+		}
+
+		if err := check(&user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) GetUser(ctx context.Context, userID models.UserID) (models.User, error) {
+	user, err := selectUser(ctx, s.pool,
+		psql.Select(userColumns...).
+			From("users").
+			Where(squirrel.Eq{"id": userID}),
+	)
+
+	return user, errors.Wrapf(err, "%s", userID)
+}
+
+// Создаёт заглушку. С groupID заглушка сразу становится участником группы.
+func (s *Storage) CreatePlaceholderUser(ctx context.Context, createdBy models.UserID, title string, groupID models.GroupID) (models.User, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	user := models.User{
+		Title:       title,
+		Placeholder: true,
+		CreatedBy:   createdBy,
+	}
+
+	err = psql.Insert("users").
+		Columns("title", "placeholder", "created_by").
+		Values(title, true, createdBy).
+		Suffix(`RETURNING "id"`).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&user.ID)
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	if groupID != 0 {
+		_, err := psql.Insert("group_members").
+			Columns("group_id", "user_id", "role").
+			Values(groupID, user.ID, string(models.GroupRoleMember)).
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			return models.User{}, errors.WithStack(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	return user, nil
+}
+
+// Создаёт настоящего пользователя. Занятое имя или внешняя учётная
+// запись - ErrUserExists.
+func (s *Storage) RegisterUser(ctx context.Context, user models.User) (models.User, error) {
+	err := psql.Insert("users").
+		Columns("title", "external_provider", "external_subject").
+		Values(
+			user.Title,
+			nullableString(user.External.Provider),
+			nullableString(user.External.Subject),
+		).
+		Suffix(`RETURNING "id", "created_at"`).
+		RunWith(s.pool).
+		QueryRowContext(ctx).
+		Scan(&user.ID, &user.CreatedAt)
+	if isUniqueViolation(err) {
+		return models.User{}, errors.Wrapf(models.ErrUserExists, "%q", user.Title)
+	}
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	return user, nil
+}
+
+func (s *Storage) RenameUser(ctx context.Context, userID models.UserID, title string) (models.User, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	user, err := lockUser(ctx, tx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if err := user.CheckActive(); err != nil {
+		return models.User{}, err
+	}
+
+	_, err = psql.Update("users").
+		Set("title", title).
+		Where(squirrel.Eq{"id": userID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if isUniqueViolation(err) {
+		return models.User{}, errors.Wrapf(models.ErrUserExists, "%q", title)
+	}
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	user.Title = title
+
+	return user, nil
+}
+
+// Повторная деактивация ничего не меняет.
+func (s *Storage) DeactivateUser(ctx context.Context, userID models.UserID, now time.Time) (models.User, error) {
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	user, err := lockUser(ctx, tx, userID)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if user.MergedInto != 0 {
+		return models.User{}, errors.Wrapf(models.ErrUserMerged, "%s into %s", user.ID, user.MergedInto)
+	}
+
+	if !user.DeactivatedAt.IsZero() {
+		return user, nil
+	}
+
+	_, err = psql.Update("users").
+		Set("deactivated_at", now).
+		Where(squirrel.Eq{"id": userID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.User{}, errors.WithStack(err)
+	}
+
+	user.DeactivatedAt = now
+
+	return user, nil
+}
+
+// Экранирует спецсимволы LIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Активные пользователи, чьё имя начинается с prefix, без учёта регистра.
+// Забранные заглушки не показываются: вместо них есть настоящий пользователь.
+func (s *Storage) SearchUsers(ctx context.Context, prefix string, limit uint64) ([]models.User, error) {
+	return selectUsers(ctx, s.pool,
+		psql.Select(userColumns...).
+			From("users").
+			Where(squirrel.Expr("lower(title) LIKE lower(?) || '%'", likeEscaper.Replace(prefix))).
+			Where(squirrel.Eq{
+				"deactivated_at": nil,
+				"merged_into":    nil,
+				"claimed_by":     nil,
+			}).
+			OrderBy("lower(title)", "id").
+			Limit(limit),
+	)
+}
+
+// Контрагенты по проводкам, участники общих групп и заглушки, заведённые
+// пользователем. Может содержать самого пользователя.
+func (s *Storage) GetUserContacts(ctx context.Context, userID models.UserID) ([]models.UserID, error) {
+	const query = `
+		SELECT user_to FROM accounting_entries WHERE user_from = $1
+		UNION
+		SELECT user_from FROM accounting_entries WHERE user_to = $1
+		UNION
+		SELECT m.user_id
+		FROM group_members m
+		JOIN group_members self ON self.group_id = m.group_id
+		WHERE self.user_id = $1
+		UNION
+		SELECT id FROM users WHERE placeholder AND created_by = $1`
+
+	var userIDs []models.UserID
+	if err := sqlx.SelectContext(ctx, s.pool, &userIDs, query, userID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,266 @@
+package pgsql
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Сливает дубль sourceID в targetID в одной транзакции и пишет слияние
+// в журнал user_merges. С dryRun всё откатывается, возвращаются только
+// подсчёты.
+func (s *Storage) MergeUsers(ctx context.Context, targetID, sourceID models.UserID, dryRun bool) (models.UserMerge, error) {
+	merge := models.UserMerge{TargetID: targetID, SourceID: sourceID, DryRun: dryRun}
+	if err := merge.Validate(); err != nil {
+		return models.UserMerge{}, err
+	}
+
+	tx, err := s.pool.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := psql.Select(userColumns...).
+		From("users").
+		Where(squirrel.Eq{"id": []models.UserID{targetID, sourceID}}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+
+	var records []dbUser
+	if err := sqlx.SelectContext(ctx, tx, &records, query, args...); err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+
+	users := map[models.UserID]models.User{}
+	for _, r := range records {
+		users[r.ID] = r.toModel()
+	}
+
+	for _, userID := range []models.UserID{targetID, sourceID} {
+		user, ok := users[userID]
+		if !ok {
+			return models.UserMerge{}, errors.Wrapf(models.ErrUserNotFound, "%s", userID)
+		}
+
+		if user.MergedInto != 0 {
+			return models.UserMerge{}, errors.Wrapf(models.ErrUserMerged, "%s into %s", userID, user.MergedInto)
+		}
+	}
+
+	merge.UserReassignment, err = reassignUser(ctx, tx, sourceID, targetID)
+	if err != nil {
+		return models.UserMerge{}, err
+	}
+
+	if dryRun {
+		return merge, nil
+	}
+
+	_, err = psql.Update("users").
+		Set("merged_into", targetID).
+		Where(squirrel.Eq{"id": sourceID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+
+	report, err := json.Marshal(merge.UserReassignment)
+	if err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+
+	err = psql.Insert("user_merges").
+		Columns("target_user_id", "source_user_id", "report").
+		Values(targetID, sourceID, string(report)).
+		Suffix(`RETURNING "id", "created_at"`).
+		RunWith(tx).
+		QueryRowContext(ctx).
+		Scan(&merge.ID, &merge.CreatedAt)
+	if err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.UserMerge{}, errors.WithStack(err)
+	}
+
+	return merge, nil
+}
+
+// Переносит на to всё, что записано на from. Проводки и погашения между
+// from и to после переноса стали бы долгом самому себе, поэтому удаляются.
+func reassignUser(ctx context.Context, tx *sqlx.Tx, from, to models.UserID) (models.UserReassignment, error) {
+	res := models.UserReassignment{}
+
+	statements := []struct {
+		stmt    squirrel.Sqlizer
+		counter *int64
+	}{
+		// В группах, где есть оба, остаётся членство to с более сильной ролью.
+		{stmt: psql.Update("group_members").
+			Set("role", squirrel.Expr(`(
+				SELECT f.role FROM group_members f
+				WHERE f.group_id = group_members.group_id AND f.user_id = ?
+			)`, from)).
+			Where(squirrel.Eq{"user_id": to}).
+			Where(`EXISTS (
+				SELECT 1 FROM group_members f
+				WHERE f.group_id = group_members.group_id AND f.user_id = ?
+					AND (f.role = 'owner' OR (f.role = 'admin' AND group_members.role = 'member'))
+			)`, from)},
+		{stmt: psql.Delete("group_members").
+			Where(squirrel.Eq{"user_id": from}).
+			Where("group_id IN (SELECT group_id FROM group_members WHERE user_id = ?)", to),
+			counter: &res.MembershipsMerged},
+		{stmt: psql.Update("group_members").
+			Set("user_id", to).
+			Where(squirrel.Eq{"user_id": from}),
+			counter: &res.MembershipsMoved},
+
+		{stmt: psql.Delete("accounting_entries").
+			Where(squirrel.Or{
+				squirrel.Eq{"user_from": from, "user_to": to},
+				squirrel.Eq{"user_from": to, "user_to": from},
+			}),
+			counter: &res.EntriesDropped},
+		{stmt: psql.Update("accounting_entries").
+			Set("user_from", squirrel.Expr("CASE WHEN user_from = ? THEN ? ELSE user_from END", from, to)).
+			Set("user_to", squirrel.Expr("CASE WHEN user_to = ? THEN ? ELSE user_to END", from, to)).
+			Where(squirrel.Or{
+				squirrel.Eq{"user_from": from},
+				squirrel.Eq{"user_to": from},
+			}),
+			counter: &res.EntriesMoved},
+
+		// Вместе с объектом учёта удаляются погашение и его проводки.
+		{stmt: psql.Delete("owner_objects").
+			Where(squirrel.Expr(`id IN (
+				SELECT owning_object_id
+				FROM accounting_settlements
+				WHERE (payer_id = ? AND payee_id = ?) OR (payer_id = ? AND payee_id = ?)
+			)`, from, to, to, from)),
+			counter: &res.SettlementsDropped},
+		{stmt: psql.Update("accounting_settlements").
+			Set("payer_id", squirrel.Expr("CASE WHEN payer_id = ? THEN ? ELSE payer_id END", from, to)).
+			Set("payee_id", squirrel.Expr("CASE WHEN payee_id = ? THEN ? ELSE payee_id END", from, to)).
+			Where(squirrel.Or{
+				squirrel.Eq{"payer_id": from},
+				squirrel.Eq{"payee_id": from},
+			}),
+			counter: &res.SettlementsMoved},
+
+		// user_id проводок, счетов и погашений обновится каскадом.
+		{stmt: psql.Update("owner_objects").
+			Set("user_id", to).
+			Where(squirrel.Eq{"user_id": from})},
+		{stmt: psql.Update("accounting_split_the_bill_revisions").
+			Set("user_id", to).
+			Where(squirrel.Eq{"user_id": from})},
+		{stmt: psql.Update("groups").
+			Set("created_by", to).
+			Where(squirrel.Eq{"created_by": from})},
+		{stmt: psql.Update("users").
+			Set("created_by", to).
+			Where(squirrel.Eq{"created_by": from})},
+		{stmt: psql.Update("user_invites").
+			Set("created_by", to).
+			Where(squirrel.Eq{"created_by": from})},
+		{stmt: psql.Update("user_invites").
+			Set("claimed_by", to).
+			Where(squirrel.Eq{"claimed_by": from})},
+	}
+
+	for _, s := range statements {
+		query, args, err := s.stmt.ToSql()
+		if err != nil {
+			return models.UserReassignment{}, errors.WithStack(err)
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return models.UserReassignment{}, errors.WithStack(err)
+		}
+
+		if s.counter != nil {
+			if *s.counter, err = result.RowsAffected(); err != nil {
+				return models.UserReassignment{}, errors.WithStack(err)
+			}
+		}
+	}
+
+	var err error
+	if res.BillsRewritten, err = reassignBillsUser(ctx, tx, false, from, to); err != nil {
+		return models.UserReassignment{}, err
+	}
+
+	if res.RevisionsRewritten, err = reassignBillsUser(ctx, tx, true, from, to); err != nil {
+		return models.UserReassignment{}, err
+	}
+
+	return res, nil
+}
+
+// Переписывает JSON счетов (или их ревизий), в которых есть from, на текущую
+// версию схемы с to вместо from.
+func reassignBillsUser(ctx context.Context, tx *sqlx.Tx, revisions bool, from, to models.UserID) (int64, error) {
+	table, columns := "accounting_split_the_bill", []string{"id", "0 AS revision"}
+	if revisions {
+		table, columns = "accounting_split_the_bill_revisions", []string{"bill_id AS id", "revision"}
+	}
+
+	query, args, err := psql.Select(append(columns, "schema_version", "bill")...).
+		From(table).
+		Where(billParticipantExpr(from)).
+		OrderBy("1, 2").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	var records []struct {
+		ID            models.BillID `db:"id"`
+		Revision      int           `db:"revision"`
+		SchemaVersion int           `db:"schema_version"`
+		Bill          []byte        `db:"bill"`
+	}
+	if err := sqlx.SelectContext(ctx, tx, &records, query, args...); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	for _, r := range records {
+		bill, err := decodeBill(r.SchemaVersion, r.Bill)
+		if err != nil {
+			return 0, errors.Wrapf(err, "%s revision %d", r.ID, r.Revision)
+		}
+
+		bill = bill.WithUserReplaced(from, to)
+
+		where := squirrel.Eq{"id": r.ID}
+		if revisions {
+			where = squirrel.Eq{"bill_id": r.ID, "revision": r.Revision}
+		}
+
+		_, err = psql.Update(table).
+			Set("schema_version", bill.GetSchemaVersion()).
+			Set("bill", dbBill(bill)).
+			Where(where).
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+	}
+
+	return int64(len(records)), nil
+}
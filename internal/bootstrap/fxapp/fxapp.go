@@ -7,7 +7,9 @@ import (
 
 	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/logger"
+	"github.com/SlamJam/dolgovnya-backend/internal/bootstrap/fxauth"
 	"github.com/SlamJam/dolgovnya-backend/internal/bootstrap/fxconfig"
+	"github.com/SlamJam/dolgovnya-backend/internal/bootstrap/fxservices"
 	"github.com/SlamJam/dolgovnya-backend/internal/bootstrap/fxstorage"
 	"github.com/rs/zerolog"
 	"go.uber.org/fx"
@@ -30,7 +32,9 @@ func NewApp(opts ...fx.Option) *fx.App {
 
 var Module = fx.Module("app",
 	fxstorage.Module,
+	fxservices.Module,
 	fxconfig.Module,
+	fxauth.Module,
 	fx.Provide(NewZapLogger),
 	fx.Provide(NewZeroLogger),
 	fx.Provide(NewContext),
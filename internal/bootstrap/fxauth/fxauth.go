@@ -0,0 +1,13 @@
+package fxauth
+
+import (
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module("auth",
+	fx.Provide(auth.NewVerifier),
+	fx.Provide(auth.NewIssuer),
+	fx.Provide(authz.NewPolicy),
+)
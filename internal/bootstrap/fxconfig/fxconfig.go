@@ -1,15 +1,114 @@
 package fxconfig
 
 import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/pkg/errors"
 	"go.uber.org/fx"
 )
 
+// Секрет HS256 для локального запуска. Вне DOLGOVNYA_LOCAL_RUN не принимается.
+const localDevHMACSecret = "local-development-secret"
+
+var (
+	ErrDevSecretInProduction = errors.New("development HMAC secret outside local run")
+)
+
+// Конфиг из переменных окружения DOLGOVNYA_*. Ключи проверки токенов:
+// DOLGOVNYA_AUTH_HMAC_SECRET, DOLGOVNYA_AUTH_PUBLIC_KEY_FILES (PEM-файлы
+// через запятую) и DOLGOVNYA_AUTH_JWKS_FILE. Если ни один не задан,
+// секрет для разработки подставляется только при DOLGOVNYA_LOCAL_RUN=1,
+// иначе сервер не стартует (см. auth.NewVerifier). Внутренний сервер
+// слушает DOLGOVNYA_INTERNAL_ADDR, по умолчанию только loopback.
 func NewConfig() (config.Config, error) {
-	return config.Config{
-		IsLocalRun: true,
-		DSN:        "postgresql://postgres@localhost",
-	}, nil
+	isLocalRun, err := envBool("DOLGOVNYA_LOCAL_RUN")
+	if err != nil {
+		return config.Config{}, err
+	}
+
+	tokenTTL, err := envDuration("DOLGOVNYA_AUTH_TOKEN_TTL", 24*time.Hour)
+	if err != nil {
+		return config.Config{}, err
+	}
+
+	cfg := config.Config{
+		IsLocalRun: isLocalRun,
+		DSN:        envOr("DOLGOVNYA_DSN", "postgresql://postgres@localhost"),
+		Auth: config.Auth{
+			HMACSecret:     os.Getenv("DOLGOVNYA_AUTH_HMAC_SECRET"),
+			JWKSFile:       os.Getenv("DOLGOVNYA_AUTH_JWKS_FILE"),
+			Issuer:         envOr("DOLGOVNYA_AUTH_ISSUER", "dolgovnya"),
+			SigningKeyFile: os.Getenv("DOLGOVNYA_AUTH_SIGNING_KEY_FILE"),
+			SigningKeyID:   os.Getenv("DOLGOVNYA_AUTH_SIGNING_KEY_ID"),
+			TokenTTL:       tokenTTL,
+		},
+		Authz: config.Authz{
+			DeleteGracePeriod: 15 * time.Minute,
+		},
+		Invites: config.Invites{
+			TTL:      7 * 24 * time.Hour,
+			LinkBase: "dolgovnya://invite",
+		},
+		Internal: config.Internal{
+			Addr: envOr("DOLGOVNYA_INTERNAL_ADDR", "127.0.0.1:8086"),
+		},
+	}
+
+	for _, path := range strings.Split(os.Getenv("DOLGOVNYA_AUTH_PUBLIC_KEY_FILES"), ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return config.Config{}, errors.WithStack(err)
+		}
+
+		cfg.Auth.PublicKeysPEM = append(cfg.Auth.PublicKeysPEM, string(data))
+	}
+
+	hasKeys := cfg.Auth.HMACSecret != "" || len(cfg.Auth.PublicKeysPEM) != 0 || cfg.Auth.JWKSFile != ""
+	switch {
+	case cfg.IsLocalRun && !hasKeys:
+		cfg.Auth.HMACSecret = localDevHMACSecret
+	case !cfg.IsLocalRun && cfg.Auth.HMACSecret == localDevHMACSecret:
+		return config.Config{}, ErrDevSecretInProduction
+	}
+
+	return cfg, nil
+}
+
+func envOr(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+
+	return fallback
+}
+
+func envBool(key string) (bool, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	return b, errors.Wrap(err, key)
+}
+
+func envDuration(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	return d, errors.Wrap(err, key)
 }
 
 var Module = fx.Module("config",
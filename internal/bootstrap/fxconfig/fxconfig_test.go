@@ -0,0 +1,58 @@
+package fxconfig_test
+
+import (
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/bootstrap/fxconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfigAuthKeys(t *testing.T) {
+	require := require.New(t)
+
+	t.Setenv("DOLGOVNYA_LOCAL_RUN", "")
+	t.Setenv("DOLGOVNYA_AUTH_HMAC_SECRET", "")
+	t.Setenv("DOLGOVNYA_AUTH_PUBLIC_KEY_FILES", "")
+	t.Setenv("DOLGOVNYA_AUTH_JWKS_FILE", "")
+
+	// Не локально и без ключей: конфиг есть, но сервер не стартует.
+	cfg, err := fxconfig.NewConfig()
+	require.NoError(err)
+	require.Empty(cfg.Auth.HMACSecret)
+	_, err = auth.NewVerifier(cfg)
+	require.ErrorIs(err, auth.ErrNoKeys)
+
+	t.Setenv("DOLGOVNYA_AUTH_HMAC_SECRET", "local-development-secret")
+	_, err = fxconfig.NewConfig()
+	require.ErrorIs(err, fxconfig.ErrDevSecretInProduction)
+
+	t.Setenv("DOLGOVNYA_AUTH_HMAC_SECRET", "prod-secret")
+	cfg, err = fxconfig.NewConfig()
+	require.NoError(err)
+	require.Equal("prod-secret", cfg.Auth.HMACSecret)
+
+	t.Setenv("DOLGOVNYA_AUTH_HMAC_SECRET", "")
+	t.Setenv("DOLGOVNYA_LOCAL_RUN", "1")
+	cfg, err = fxconfig.NewConfig()
+	require.NoError(err)
+	require.True(cfg.IsLocalRun)
+	_, err = auth.NewVerifier(cfg)
+	require.NoError(err)
+}
+
+func TestNewConfigInternalAddr(t *testing.T) {
+	require := require.New(t)
+
+	t.Setenv("DOLGOVNYA_LOCAL_RUN", "1")
+	t.Setenv("DOLGOVNYA_INTERNAL_ADDR", "")
+
+	cfg, err := fxconfig.NewConfig()
+	require.NoError(err)
+	require.Equal("127.0.0.1:8086", cfg.Internal.Addr)
+
+	t.Setenv("DOLGOVNYA_INTERNAL_ADDR", "10.0.0.5:9000")
+	cfg, err = fxconfig.NewConfig()
+	require.NoError(err)
+	require.Equal("10.0.0.5:9000", cfg.Internal.Addr)
+}
@@ -1,10 +1,19 @@
 package fxhttp
 
 import (
+	connect_handlers "github.com/SlamJam/dolgovnya-backend/internal/connect-handlers"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Module("http",
+	fx.Provide(connect_handlers.NewSplitTheBillServiceHandler),
+	fx.Provide(connect_handlers.NewSettlementServiceHandler),
+	fx.Provide(connect_handlers.NewBalanceServiceHandler),
+	fx.Provide(connect_handlers.NewGroupServiceHandler),
+	fx.Provide(connect_handlers.NewInviteServiceHandler),
+	fx.Provide(connect_handlers.NewExportServiceHandler),
+	fx.Provide(connect_handlers.NewInternalServiceHandler),
 	fx.Provide(NewHTTPServer),
 	fx.Provide(NewConnectServer),
+	fx.Provide(NewInternalConnectServer),
 )
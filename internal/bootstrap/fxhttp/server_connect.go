@@ -5,10 +5,18 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
 	"github.com/SlamJam/dolgovnya-backend/internal/components"
 	connect_handlers "github.com/SlamJam/dolgovnya-backend/internal/connect-handlers"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/balance/v1/balancev1connect"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/export/v1/exportv1connect"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/group/v1/groupv1connect"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/invite/v1/invitev1connect"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/settlement/v1/settlementv1connect"
 	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/split_the_bill/v1/split_the_billv1connect"
 	"github.com/SlamJam/go-libs/component"
+	"github.com/bufbuild/connect-go"
 	"go.uber.org/fx"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -16,17 +24,40 @@ import (
 
 type ConnectServer component.Component
 
-func NewConnectServer(lc fx.Lifecycle) ConnectServer {
+type connectServerParams struct {
+	fx.In
+
+	Lc       fx.Lifecycle
+	Verifier *auth.Verifier
+	Users    *services.UserService
+
+	SplitTheBill *connect_handlers.SplitTheBillServiceHandler
+	Settlement   *connect_handlers.SettlementServiceHandler
+	Balance      *connect_handlers.BalanceServiceHandler
+	Group        *connect_handlers.GroupServiceHandler
+	Invite       *connect_handlers.InviteServiceHandler
+	Export       *connect_handlers.ExportServiceHandler
+}
+
+func NewConnectServer(p connectServerParams) ConnectServer {
 	addr := ":8085"
 	mux := http.NewServeMux()
+	interceptors := connect.WithInterceptors(
+		connect_handlers.NewAuthInterceptor(p.Verifier, p.Users),
+	)
 	// The generated constructors return a path and a plain net/http handler.
-	mux.Handle(split_the_billv1connect.NewSplitTheBillServiceHandler(&connect_handlers.SplitTheBillServiceHandler{}))
+	mux.Handle(split_the_billv1connect.NewSplitTheBillServiceHandler(p.SplitTheBill, interceptors))
+	mux.Handle(settlementv1connect.NewSettlementServiceHandler(p.Settlement, interceptors))
+	mux.Handle(balancev1connect.NewBalanceServiceHandler(p.Balance, interceptors))
+	mux.Handle(groupv1connect.NewGroupServiceHandler(p.Group, interceptors))
+	mux.Handle(invitev1connect.NewInviteServiceHandler(p.Invite, interceptors))
+	mux.Handle(exportv1connect.NewExportServiceHandler(p.Export, interceptors))
 
 	// For gRPC clients, it's convenient to support HTTP/2 without TLS. You can
 	// avoid x/net/http2 by using http.ListenAndServeTLS.
 	c := components.NewHttpServer(addr, h2c.NewHandler(mux, &http2.Server{}))
 
-	lc.Append(fx.Hook{
+	p.Lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			fmt.Println("Starting Connect server at", addr)
 			c.Start(ctx)
@@ -0,0 +1,48 @@
+package fxhttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/components"
+	connect_handlers "github.com/SlamJam/dolgovnya-backend/internal/connect-handlers"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/internalapi/v1/internalapiv1connect"
+	"github.com/SlamJam/go-libs/component"
+	"go.uber.org/fx"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// Connect-сервер для внутренних операций. Пользователя не проверяет,
+// поэтому слушает config.Internal.Addr, по умолчанию только loopback.
+type InternalConnectServer component.Component
+
+type internalConnectServerParams struct {
+	fx.In
+
+	Lc  fx.Lifecycle
+	Cfg config.Config
+
+	Internal *connect_handlers.InternalServiceHandler
+}
+
+func NewInternalConnectServer(p internalConnectServerParams) InternalConnectServer {
+	addr := p.Cfg.Internal.Addr
+	mux := http.NewServeMux()
+	mux.Handle(internalapiv1connect.NewInternalServiceHandler(p.Internal))
+
+	c := components.NewHttpServer(addr, h2c.NewHandler(mux, &http2.Server{}))
+
+	p.Lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			fmt.Println("Starting internal Connect server at", addr)
+			c.Start(ctx)
+			return nil
+		},
+		OnStop: c.Interrupt,
+	})
+
+	return c
+}
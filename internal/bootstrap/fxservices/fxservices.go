@@ -0,0 +1,18 @@
+package fxservices
+
+import (
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module("services",
+	fx.Provide(services.NewSplitTheBillService),
+	fx.Provide(services.NewSettlementService),
+	fx.Provide(services.NewBalanceService),
+	fx.Provide(services.NewGroupService),
+	fx.Provide(services.NewInviteService),
+	fx.Provide(services.NewUserService),
+	fx.Provide(services.NewExchangeRateService),
+	fx.Provide(services.NewExportService),
+	fx.Provide(services.NewBillImportService),
+)
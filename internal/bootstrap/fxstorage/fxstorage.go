@@ -24,7 +24,47 @@ func newSplitTheBillStorage(lc fx.Lifecycle, s *pgsql.Storage) services.SplitThe
 	return s
 }
 
+func newSettlementStorage(s *pgsql.Storage) services.SettlementStorage {
+	return s
+}
+
+func newBalanceStorage(s *pgsql.Storage) services.BalanceStorage {
+	return s
+}
+
+func newGroupStorage(s *pgsql.Storage) services.GroupStorage {
+	return s
+}
+
+func newInviteStorage(s *pgsql.Storage) services.InviteStorage {
+	return s
+}
+
+func newUserStorage(s *pgsql.Storage) services.UserStorage {
+	return s
+}
+
+func newExchangeRateStorage(s *pgsql.Storage) services.ExchangeRateStorage {
+	return s
+}
+
+func newExportStorage(s *pgsql.Storage) services.ExportStorage {
+	return s
+}
+
+func newBillImportStorage(s *pgsql.Storage) services.BillImportStorage {
+	return s
+}
+
 var Module = fx.Module("pgsql",
 	fx.Provide(NewPgStorage),
 	fx.Provide(newSplitTheBillStorage),
+	fx.Provide(newSettlementStorage),
+	fx.Provide(newBalanceStorage),
+	fx.Provide(newGroupStorage),
+	fx.Provide(newInviteStorage),
+	fx.Provide(newUserStorage),
+	fx.Provide(newExchangeRateStorage),
+	fx.Provide(newExportStorage),
+	fx.Provide(newBillImportStorage),
 )
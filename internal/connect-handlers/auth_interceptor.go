@@ -0,0 +1,99 @@
+package connect_handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/bufbuild/connect-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var (
+	ErrNoBearerToken = errors.New("no bearer token")
+)
+
+// Проверяет JWT из заголовка Authorization и кладёт пользователя в контекст.
+// Токены слитых и деактивированных пользователей не принимаются. Работает и для потоковых методов.
+func NewAuthInterceptor(verifier *auth.Verifier, users *services.UserService) connect.Interceptor {
+	return &authInterceptor{verifier: verifier, users: users}
+}
+
+type authInterceptor struct {
+	verifier *auth.Verifier
+	users    *services.UserService
+}
+
+func (i *authInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	token, ok := bearerToken(header.Get(authorizationHeader))
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrNoBearerToken)
+	}
+
+	userID, err := i.verifier.Verify(token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	user, err := i.users.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, errorToConnect(err)
+	}
+
+	if err := user.CheckActive(); err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	return auth.WithUserID(ctx, userID), nil
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		return next(ctx, conn)
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(header[len(bearerPrefix):])
+
+	return token, token != ""
+}
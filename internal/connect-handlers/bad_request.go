@@ -0,0 +1,57 @@
+package connect_handlers
+
+import (
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+var (
+	ErrInvalidRequest = errors.New("invalid request")
+)
+
+// Собирает ошибки валидации по полям запроса, чтобы вернуть их все разом
+// в errdetails.BadRequest.
+type badRequest struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (b *badRequest) add(field string, err error) {
+	b.violations = append(b.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: err.Error(),
+	})
+}
+
+func (b *badRequest) empty() bool {
+	return len(b.violations) == 0
+}
+
+func (b *badRequest) err() *connect.Error {
+	if b.empty() {
+		return nil
+	}
+
+	first := b.violations[0]
+	connectErr := connect.NewError(
+		connect.CodeInvalidArgument,
+		errors.Wrapf(ErrInvalidRequest, "%s: %s", first.Field, first.Description),
+	)
+
+	detail, err := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: b.violations})
+	if err == nil {
+		connectErr.AddDetail(detail)
+	}
+
+	return connectErr
+}
+
+func fieldPath(parent string, index int, field string) string {
+	return fmt.Sprintf("%s[%d].%s", parent, index, field)
+}
+
+func indexPath(field string, index int) string {
+	return fmt.Sprintf("%s[%d]", field, index)
+}
@@ -0,0 +1,215 @@
+package connect_handlers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/converter"
+	balancev1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/balance/v1"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/balance/v1/balancev1connect"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Пользователь видит только свои балансы, поэтому политика не нужна.
+type BalanceServiceHandler struct {
+	balancev1connect.UnimplementedBalanceServiceHandler
+	service *services.BalanceService
+}
+
+func NewBalanceServiceHandler(service *services.BalanceService) *BalanceServiceHandler {
+	return &BalanceServiceHandler{
+		service: service,
+	}
+}
+
+var netKindToPb = map[models.NetKind]balancev1.NetKind{
+	models.Zero:     balancev1.NetKind_NET_KIND_ZERO,
+	models.Surplus:  balancev1.NetKind_NET_KIND_SURPLUS,
+	models.Scarcity: balancev1.NetKind_NET_KIND_SCARCITY,
+}
+
+func (h *BalanceServiceHandler) GetMyBalance(ctx context.Context, req *connect.Request[balancev1.GetMyBalanceRequest]) (*connect.Response[balancev1.GetMyBalanceResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	accounts, err := h.service.GetBalance(ctx, userID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	currencies := make([]models.Currency, 0, len(accounts))
+	for currency := range accounts {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i] < currencies[j] })
+
+	res := &balancev1.GetMyBalanceResponse{
+		Balances: make([]*balancev1.CurrencyBalance, 0, len(currencies)),
+	}
+	for _, currency := range currencies {
+		account := accounts[currency]
+		net, kind := account.AbsNet()
+
+		res.Balances = append(res.Balances, &balancev1.CurrencyBalance{
+			Debit:   converter.MoneyToPb(account.Debit, currency),
+			Credit:  converter.MoneyToPb(account.Credit, currency),
+			Net:     converter.MoneyToPb(net, currency),
+			NetKind: netKindToPb[kind],
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (h *BalanceServiceHandler) GetCounterpartyBalances(ctx context.Context, req *connect.Request[balancev1.GetCounterpartyBalancesRequest]) (*connect.Response[balancev1.GetCounterpartyBalancesResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	if req.Msg.ConvertTo == "" {
+		balances, err := h.service.GetCounterpartyBalances(ctx, userID)
+		if err != nil {
+			return nil, errorToConnect(err)
+		}
+
+		return connect.NewResponse(&balancev1.GetCounterpartyBalancesResponse{
+			Balances: counterpartyBalancesToPb(balances),
+		}), nil
+	}
+
+	currency, err := converter.CurrencyFromPb(req.Msg.ConvertTo)
+	if err != nil {
+		br := &badRequest{}
+		br.add("convert_to", err)
+		return nil, br.err()
+	}
+
+	conversion := services.BalanceConversion{Currency: currency}
+	if req.Msg.RatesDate != nil {
+		conversion.Date = req.Msg.RatesDate.AsTime()
+	}
+
+	converted, err := h.service.GetConvertedBalances(ctx, userID, conversion)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	res := &balancev1.GetCounterpartyBalancesResponse{
+		Balances: counterpartyBalancesToPb(models.BalancesByCurrency{converted.Currency: converted.Balances}),
+		Rates:    make([]*balancev1.ExchangeRate, 0, len(converted.Rates)),
+		Residue:  converter.RatToPb(converted.Residue.Rat, residueDigits),
+	}
+	for _, rate := range converted.Rates {
+		res.Rates = append(res.Rates, &balancev1.ExchangeRate{
+			Base:  rate.Base.String(),
+			Quote: rate.Quote.String(),
+			Rate:  converter.DecimalToPb(rate.Rate),
+			Date:  timestamppb.New(rate.Date),
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// Остаток от округления меньше минимальной единицы валюты на каждого
+// контрагента, поэтому отдаётся с запасом знаков.
+const residueDigits = 12
+
+var statementSourceToPb = map[models.StatementSource]balancev1.StatementSource{
+	models.StatementSourceBill:       balancev1.StatementSource_STATEMENT_SOURCE_BILL,
+	models.StatementSourceSettlement: balancev1.StatementSource_STATEMENT_SOURCE_SETTLEMENT,
+}
+
+func (h *BalanceServiceHandler) GetStatement(ctx context.Context, req *connect.Request[balancev1.GetStatementRequest]) (*connect.Response[balancev1.GetStatementResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	afterID, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := normalizePageSize(req.Msg.PageSize)
+	filter := models.StatementFilter{
+		CounterpartyID: models.UserID(req.Msg.CounterpartyId),
+		GroupID:        models.GroupID(req.Msg.GroupId),
+		AfterEntryID:   afterID,
+		// Берём на один больше, чтобы понять, есть ли следующая страница.
+		Limit: pageSize + 1,
+	}
+
+	if req.Msg.From != nil {
+		filter.From = req.Msg.From.AsTime()
+	}
+
+	if req.Msg.To != nil {
+		filter.To = req.Msg.To.AsTime()
+	}
+
+	entries, err := h.service.GetStatement(ctx, userID, filter)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	var nextPageToken string
+	if uint64(len(entries)) > pageSize {
+		entries = entries[:pageSize]
+		nextPageToken = encodePageToken(entries[len(entries)-1].EntryID)
+	}
+
+	res := &balancev1.GetStatementResponse{
+		Entries:       make([]*balancev1.StatementEntry, 0, len(entries)),
+		NextPageToken: nextPageToken,
+	}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, &balancev1.StatementEntry{
+			EntryId:        entry.EntryID,
+			CreatedAt:      timestamppb.New(entry.CreatedAt),
+			Source:         statementSourceToPb[entry.Source],
+			BillId:         uint64(entry.BillID),
+			SettlementId:   uint64(entry.SettlementID),
+			GroupId:        int64(entry.GroupID),
+			CounterpartyId: int64(entry.CounterpartyID),
+			Amount:         converter.MoneyToPb(entry.Amount, entry.Currency),
+			Balance:        converter.MoneyToPb(entry.Balance, entry.Currency),
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func counterpartyBalancesToPb(balances models.BalancesByCurrency) []*balancev1.CounterpartyBalance {
+	currencies := make([]models.Currency, 0, len(balances))
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i] < currencies[j] })
+
+	res := []*balancev1.CounterpartyBalance{}
+	for _, currency := range currencies {
+		userIDs := make([]models.UserID, 0, len(balances[currency]))
+		for userID, balance := range balances[currency] {
+			if !balance.IsZero() {
+				userIDs = append(userIDs, userID)
+			}
+		}
+		sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+		for _, userID := range userIDs {
+			res = append(res, &balancev1.CounterpartyBalance{
+				UserId: int64(userID),
+				Amount: converter.MoneyToPb(balances[currency][userID], currency),
+			})
+		}
+	}
+
+	return res
+}
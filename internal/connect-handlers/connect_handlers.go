@@ -1,13 +1,69 @@
 package connect_handlers
 
 import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/exporter"
 	"github.com/bufbuild/connect-go"
+	"github.com/pkg/errors"
 )
 
-func userIDFromRequest[T any](req *connect.Request[T]) (models.UserID, error) {
-	// Headers -> JWT -> UserID
-	return models.UserID(1), nil
+// Пользователя в контекст кладёт NewAuthInterceptor.
+func userIDFromCtx(ctx context.Context) (models.UserID, error) {
+	return auth.UserIDFromCtx(ctx)
 }
 
-// req *connect.Request[pb.SplitRequest]
+// Ошибки сервисов -> коды Connect. Всё неизвестное - CodeInternal.
+func errorToConnect(err error) *connect.Error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	switch {
+	case errors.Is(err, models.ErrBillNotFound),
+		errors.Is(err, models.ErrGroupNotFound),
+		errors.Is(err, models.ErrUserNotFound),
+		errors.Is(err, models.ErrInviteNotFound),
+		errors.Is(err, models.ErrExchangeRateNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, models.ErrUserExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, authz.ErrPermissionDenied):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, models.ErrRevisionConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, models.ErrNoDebt),
+		errors.Is(err, models.ErrSettlementExceedsDebt),
+		errors.Is(err, models.ErrMemberHasBalance),
+		errors.Is(err, models.ErrLastGroupOwner),
+		errors.Is(err, models.ErrAlreadyGroupMember),
+		errors.Is(err, models.ErrInviteExpired),
+		errors.Is(err, models.ErrInviteClaimed),
+		errors.Is(err, models.ErrPlaceholderClaimed),
+		errors.Is(err, models.ErrUserMerged),
+		errors.Is(err, models.ErrUserDeactivated):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, models.ErrNonPositiveAmount),
+		errors.Is(err, models.ErrSelfSettlement),
+		errors.Is(err, models.ErrMoneyPrecision),
+		errors.Is(err, models.ErrUnknownCurrency),
+		errors.Is(err, models.ErrNotGroupMember),
+		errors.Is(err, models.ErrInvalidGroup),
+		errors.Is(err, models.ErrUnknownGroupRole),
+		errors.Is(err, models.ErrInvalidUser),
+		errors.Is(err, models.ErrInvalidInvite),
+		errors.Is(err, models.ErrNotPlaceholder),
+		errors.Is(err, models.ErrSelfMerge),
+		errors.Is(err, models.ErrInvalidStatementFilter),
+		errors.Is(err, exporter.ErrUnknownFormat),
+		errors.Is(err, exporter.ErrUnknownReport),
+		errors.Is(err, exporter.ErrInvalidPeriod):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
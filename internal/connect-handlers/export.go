@@ -0,0 +1,139 @@
+package connect_handlers
+
+import (
+	"bufio"
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/exporter"
+	exportv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/export/v1"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/export/v1/exportv1connect"
+	"github.com/bufbuild/connect-go"
+	"github.com/pkg/errors"
+)
+
+// Размер куска файла в одном сообщении стрима.
+const exportChunkSize = 32 << 10
+
+// Пользователь выгружает только свои данные, поэтому политика не нужна.
+type ExportServiceHandler struct {
+	exportv1connect.UnimplementedExportServiceHandler
+	service *services.ExportService
+}
+
+func NewExportServiceHandler(service *services.ExportService) *ExportServiceHandler {
+	return &ExportServiceHandler{
+		service: service,
+	}
+}
+
+var reportFromPb = map[exportv1.Report]string{
+	exportv1.Report_REPORT_LEDGER: exporter.ReportLedger,
+	exportv1.Report_REPORT_BILLS:  exporter.ReportBills,
+}
+
+var formatFromPb = map[exportv1.Format]string{
+	exportv1.Format_FORMAT_CSV:  exporter.FormatCSV,
+	exportv1.Format_FORMAT_JSON: exporter.FormatJSON,
+	exportv1.Format_FORMAT_PDF:  exporter.FormatPDF,
+}
+
+func (h *ExportServiceHandler) Export(ctx context.Context, req *connect.Request[exportv1.ExportRequest], stream *connect.ServerStream[exportv1.ExportResponse]) error {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	var br badRequest
+	kind, ok := reportFromPb[req.Msg.Report]
+	if !ok {
+		br.add("report", errors.Wrapf(exporter.ErrUnknownReport, "%s", req.Msg.Report))
+	}
+	format, ok := formatFromPb[req.Msg.Format]
+	if !ok {
+		br.add("format", errors.Wrapf(exporter.ErrUnknownFormat, "%s", req.Msg.Format))
+	}
+	if !br.empty() {
+		return br.err()
+	}
+
+	period := exporter.Period{UserID: userID}
+	if req.Msg.From != nil {
+		period.From = req.Msg.From.AsTime()
+	}
+	if req.Msg.To != nil {
+		period.To = req.Msg.To.AsTime()
+	}
+
+	header, err := exporter.Header(kind, period)
+	if err != nil {
+		return errorToConnect(err)
+	}
+
+	sw := &exportStreamWriter{
+		stream: stream,
+		header: &exportv1.ExportResponse{
+			ContentType: exporter.ContentType(format),
+			FileName:    exporter.FileName(header, format),
+		},
+	}
+	w := bufio.NewWriterSize(sw, exportChunkSize)
+	fw, err := exporter.NewWriter(w, format)
+	if err != nil {
+		return errorToConnect(err)
+	}
+
+	// Куски уходят клиенту по мере чтения страниц из базы.
+	if err := h.service.Export(ctx, kind, period, fw); err != nil {
+		return errorToConnect(err)
+	}
+	if err := w.Flush(); err != nil {
+		return errorToConnect(err)
+	}
+
+	// Пустой файл: клиенту всё равно нужны имя и тип.
+	return sw.flushHeader()
+}
+
+// Режет данные на сообщения не больше exportChunkSize.
+// Первое сообщение несёт имя и тип файла.
+type exportStreamWriter struct {
+	stream *connect.ServerStream[exportv1.ExportResponse]
+	header *exportv1.ExportResponse
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := len(p)
+		if n > exportChunkSize {
+			n = exportChunkSize
+		}
+
+		if err := w.send(p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+
+	return written, nil
+}
+
+func (w *exportStreamWriter) send(data []byte) error {
+	msg := &exportv1.ExportResponse{}
+	if w.header != nil {
+		msg, w.header = w.header, nil
+	}
+	msg.Data = data
+
+	return errors.WithStack(w.stream.Send(msg))
+}
+
+func (w *exportStreamWriter) flushHeader() error {
+	if w.header == nil {
+		return nil
+	}
+
+	return w.send(nil)
+}
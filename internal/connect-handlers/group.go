@@ -0,0 +1,370 @@
+package connect_handlers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/converter"
+	groupv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/group/v1"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/group/v1/groupv1connect"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var groupRoleFromPb = map[groupv1.GroupRole]models.GroupRole{
+	groupv1.GroupRole_GROUP_ROLE_UNSPECIFIED: "",
+	groupv1.GroupRole_GROUP_ROLE_OWNER:       models.GroupRoleOwner,
+	groupv1.GroupRole_GROUP_ROLE_ADMIN:       models.GroupRoleAdmin,
+	groupv1.GroupRole_GROUP_ROLE_MEMBER:      models.GroupRoleMember,
+}
+
+type GroupServiceHandler struct {
+	groupv1connect.UnimplementedGroupServiceHandler
+	service *services.GroupService
+	policy  *authz.Policy
+}
+
+func NewGroupServiceHandler(service *services.GroupService, policy *authz.Policy) *GroupServiceHandler {
+	return &GroupServiceHandler{
+		service: service,
+		policy:  policy,
+	}
+}
+
+// Группа, которую пользователю можно смотреть.
+func (h *GroupServiceHandler) viewGroup(ctx context.Context, userID models.UserID, groupID int64) (models.Group, error) {
+	group, err := h.service.GetGroup(ctx, models.GroupID(groupID))
+	if err != nil {
+		return models.Group{}, err
+	}
+
+	if err := h.policy.CanViewGroup(userID, group); err != nil {
+		return models.Group{}, err
+	}
+
+	return group, nil
+}
+
+func (h *GroupServiceHandler) CreateGroup(ctx context.Context, req *connect.Request[groupv1.CreateGroupRequest]) (*connect.Response[groupv1.CreateGroupResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	br := &badRequest{}
+	memberIDs := make([]models.UserID, 0, len(req.Msg.MemberIds))
+	for i, id := range req.Msg.MemberIds {
+		if id <= 0 {
+			br.add(indexPath("member_ids", i), ErrInvalidUserID)
+		}
+
+		memberIDs = append(memberIDs, models.UserID(id))
+	}
+
+	if !br.empty() {
+		return nil, br.err()
+	}
+
+	group, err := h.service.CreateGroup(ctx, userID, req.Msg.Title, memberIDs)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&groupv1.CreateGroupResponse{
+		Group: groupToPb(group),
+	}), nil
+}
+
+func (h *GroupServiceHandler) GetGroup(ctx context.Context, req *connect.Request[groupv1.GetGroupRequest]) (*connect.Response[groupv1.GetGroupResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	group, err := h.viewGroup(ctx, userID, req.Msg.GroupId)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&groupv1.GetGroupResponse{
+		Group: groupToPb(group),
+	}), nil
+}
+
+func (h *GroupServiceHandler) ListGroups(ctx context.Context, req *connect.Request[groupv1.ListGroupsRequest]) (*connect.Response[groupv1.ListGroupsResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	groups, err := h.service.ListGroups(ctx, userID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	res := &groupv1.ListGroupsResponse{
+		Groups: make([]*groupv1.Group, 0, len(groups)),
+	}
+	for _, group := range groups {
+		res.Groups = append(res.Groups, groupToPb(group))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (h *GroupServiceHandler) AddGroupMember(ctx context.Context, req *connect.Request[groupv1.AddGroupMemberRequest]) (*connect.Response[groupv1.AddGroupMemberResponse], error) {
+	role := groupRoleFromPb[req.Msg.Role]
+	if role == "" {
+		role = models.GroupRoleMember
+	}
+
+	group, err := h.setMember(ctx, req.Msg.GroupId, req.Msg.UserId, role, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&groupv1.AddGroupMemberResponse{
+		Group: groupToPb(group),
+	}), nil
+}
+
+func (h *GroupServiceHandler) UpdateGroupMember(ctx context.Context, req *connect.Request[groupv1.UpdateGroupMemberRequest]) (*connect.Response[groupv1.UpdateGroupMemberResponse], error) {
+	group, err := h.setMember(ctx, req.Msg.GroupId, req.Msg.UserId, groupRoleFromPb[req.Msg.Role], false)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&groupv1.UpdateGroupMemberResponse{
+		Group: groupToPb(group),
+	}), nil
+}
+
+// Добавление нового участника (isNew) или смена роли существующего.
+func (h *GroupServiceHandler) setMember(ctx context.Context, groupID, memberID int64, role models.GroupRole, isNew bool) (models.Group, error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return models.Group{}, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	br := &badRequest{}
+	if memberID <= 0 {
+		br.add("user_id", ErrInvalidUserID)
+	}
+
+	if err := role.Validate(); err != nil {
+		br.add("role", err)
+	}
+
+	if !br.empty() {
+		return models.Group{}, br.err()
+	}
+
+	group, err := h.viewGroup(ctx, userID, groupID)
+	if err != nil {
+		return models.Group{}, errorToConnect(err)
+	}
+
+	member := models.GroupMember{UserID: models.UserID(memberID), Role: role}
+	if err := h.policy.CanManageGroupMember(userID, group, member); err != nil {
+		return models.Group{}, errorToConnect(err)
+	}
+
+	switch {
+	case isNew && group.HasMember(member.UserID):
+		return models.Group{}, errorToConnect(models.ErrAlreadyGroupMember)
+	case !isNew && !group.HasMember(member.UserID):
+		return models.Group{}, errorToConnect(models.ErrNotGroupMember)
+	}
+
+	group, err = h.service.SetMember(ctx, group.ID, member)
+	if err != nil {
+		return models.Group{}, errorToConnect(err)
+	}
+
+	return group, nil
+}
+
+func (h *GroupServiceHandler) RemoveGroupMember(ctx context.Context, req *connect.Request[groupv1.RemoveGroupMemberRequest]) (*connect.Response[groupv1.RemoveGroupMemberResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	if req.Msg.UserId <= 0 {
+		br := &badRequest{}
+		br.add("user_id", ErrInvalidUserID)
+		return nil, br.err()
+	}
+
+	group, err := h.viewGroup(ctx, userID, req.Msg.GroupId)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	memberID := models.UserID(req.Msg.UserId)
+	if err := h.policy.CanManageGroupMember(userID, group, models.GroupMember{UserID: memberID}); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	group, err = h.service.RemoveMember(ctx, group.ID, memberID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&groupv1.RemoveGroupMemberResponse{
+		Group: groupToPb(group),
+	}), nil
+}
+
+func (h *GroupServiceHandler) GetGroupBalances(ctx context.Context, req *connect.Request[groupv1.GetGroupBalancesRequest]) (*connect.Response[groupv1.GetGroupBalancesResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	group, err := h.viewGroup(ctx, userID, req.Msg.GroupId)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	balances, err := h.service.GetBalances(ctx, group.ID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&groupv1.GetGroupBalancesResponse{
+		Balances: groupBalancesToPb(balances),
+	}), nil
+}
+
+func (h *GroupServiceHandler) GetGroupSettlementPlan(ctx context.Context, req *connect.Request[groupv1.GetGroupSettlementPlanRequest]) (*connect.Response[groupv1.GetGroupSettlementPlanResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	group, err := h.viewGroup(ctx, userID, req.Msg.GroupId)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	plan, err := h.service.GetSettlementPlan(ctx, group.ID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&groupv1.GetGroupSettlementPlanResponse{
+		Transfers: transfersToPb(plan),
+	}), nil
+}
+
+func (h *GroupServiceHandler) RecordGroupSettlement(ctx context.Context, req *connect.Request[groupv1.RecordGroupSettlementRequest]) (*connect.Response[groupv1.RecordGroupSettlementResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	br := &badRequest{}
+	if req.Msg.PayerId <= 0 {
+		br.add("payer_id", ErrInvalidUserID)
+	}
+
+	if req.Msg.PayeeId <= 0 {
+		br.add("payee_id", ErrInvalidUserID)
+	}
+
+	currencyCode := req.Msg.CurrencyCode
+	if currencyCode == "" && req.Msg.Amount != nil {
+		currencyCode = req.Msg.Amount.CurrencyCode
+	}
+
+	currency, err := converter.CurrencyFromPb(currencyCode)
+	if err != nil {
+		br.add("currency_code", err)
+	}
+
+	var amount *models.Money
+	if req.Msg.Amount != nil {
+		m, err := converter.MoneyFromPb(req.Msg.Amount, currency)
+		if err != nil {
+			br.add("amount", err)
+		}
+		amount = &m
+	}
+
+	if !br.empty() {
+		return nil, br.err()
+	}
+
+	group, err := h.service.GetGroup(ctx, models.GroupID(req.Msg.GroupId))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	payerID, payeeID := models.UserID(req.Msg.PayerId), models.UserID(req.Msg.PayeeId)
+	if err := h.policy.CanRecordGroupSettlement(userID, group, models.Settlement{PayerID: payerID, PayeeID: payeeID}); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	settlement, remaining, err := h.service.RecordSettlement(ctx, userID, group.ID, payerID, payeeID, currency, amount)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&groupv1.RecordGroupSettlementResponse{
+		Settlement:    settlementToPb(settlement),
+		RemainingDebt: converter.MoneyToPb(remaining, settlement.Currency),
+	}), nil
+}
+
+func groupToPb(group models.Group) *groupv1.Group {
+	res := &groupv1.Group{
+		Id:        int64(group.ID),
+		Title:     group.Title,
+		CreatedBy: int64(group.CreatedBy),
+		CreatedAt: timestamppb.New(group.CreatedAt),
+		Members:   make([]*groupv1.GroupMember, 0, len(group.Members)),
+	}
+
+	for _, m := range group.Members {
+		res.Members = append(res.Members, &groupv1.GroupMember{
+			UserId:   int64(m.UserID),
+			Role:     enumToPb(groupRoleFromPb, m.Role),
+			JoinedAt: timestamppb.New(m.JoinedAt),
+		})
+	}
+
+	return res
+}
+
+// Ненулевые балансы по валюте и UserID.
+func groupBalancesToPb(balances models.BalancesByCurrency) []*groupv1.GroupBalance {
+	currencies := make([]models.Currency, 0, len(balances))
+	for currency := range balances {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i] < currencies[j] })
+
+	res := []*groupv1.GroupBalance{}
+	for _, currency := range currencies {
+		userIDs := make([]models.UserID, 0, len(balances[currency]))
+		for userID, balance := range balances[currency] {
+			if !balance.IsZero() {
+				userIDs = append(userIDs, userID)
+			}
+		}
+		sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+		for _, userID := range userIDs {
+			res = append(res, &groupv1.GroupBalance{
+				UserId: int64(userID),
+				Amount: converter.MoneyToPb(balances[currency][userID], currency),
+			})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,168 @@
+package connect_handlers
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	internalapiv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/internalapi/v1"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/internalapi/v1/internalapiv1connect"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Внутренние операции: доступны только во внутренней сети, без авторизации
+// пользователя.
+type InternalServiceHandler struct {
+	internalapiv1connect.UnimplementedInternalServiceHandler
+	users *services.UserService
+}
+
+func NewInternalServiceHandler(users *services.UserService) *InternalServiceHandler {
+	return &InternalServiceHandler{
+		users: users,
+	}
+}
+
+func (h *InternalServiceHandler) NewUser(ctx context.Context, req *connect.Request[internalapiv1.NewUserRequest]) (*connect.Response[internalapiv1.NewUserResponse], error) {
+	var external models.ExternalIdentity
+	if req.Msg.External != nil {
+		external = models.ExternalIdentity{
+			Provider: req.Msg.External.Provider,
+			Subject:  req.Msg.External.Subject,
+		}
+	}
+
+	user, err := h.users.NewUser(ctx, req.Msg.Title, external)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&internalapiv1.NewUserResponse{
+		Id:   int64(user.ID),
+		User: userToPb(user),
+	}), nil
+}
+
+func (h *InternalServiceHandler) GetUser(ctx context.Context, req *connect.Request[internalapiv1.GetUserRequest]) (*connect.Response[internalapiv1.GetUserResponse], error) {
+	if req.Msg.UserId <= 0 {
+		br := &badRequest{}
+		br.add("user_id", ErrInvalidUserID)
+		return nil, br.err()
+	}
+
+	user, err := h.users.GetUser(ctx, models.UserID(req.Msg.UserId))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&internalapiv1.GetUserResponse{
+		User: userToPb(user),
+	}), nil
+}
+
+func (h *InternalServiceHandler) UpdateUser(ctx context.Context, req *connect.Request[internalapiv1.UpdateUserRequest]) (*connect.Response[internalapiv1.UpdateUserResponse], error) {
+	if req.Msg.UserId <= 0 {
+		br := &badRequest{}
+		br.add("user_id", ErrInvalidUserID)
+		return nil, br.err()
+	}
+
+	user, err := h.users.RenameUser(ctx, models.UserID(req.Msg.UserId), req.Msg.Title)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&internalapiv1.UpdateUserResponse{
+		User: userToPb(user),
+	}), nil
+}
+
+func (h *InternalServiceHandler) SearchUsers(ctx context.Context, req *connect.Request[internalapiv1.SearchUsersRequest]) (*connect.Response[internalapiv1.SearchUsersResponse], error) {
+	users, err := h.users.SearchUsers(ctx, req.Msg.Prefix, normalizePageSize(req.Msg.PageSize))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	res := &internalapiv1.SearchUsersResponse{
+		Users: make([]*internalapiv1.User, 0, len(users)),
+	}
+	for _, user := range users {
+		res.Users = append(res.Users, userToPb(user))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (h *InternalServiceHandler) DeactivateUser(ctx context.Context, req *connect.Request[internalapiv1.DeactivateUserRequest]) (*connect.Response[internalapiv1.DeactivateUserResponse], error) {
+	if req.Msg.UserId <= 0 {
+		br := &badRequest{}
+		br.add("user_id", ErrInvalidUserID)
+		return nil, br.err()
+	}
+
+	user, err := h.users.DeactivateUser(ctx, models.UserID(req.Msg.UserId))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&internalapiv1.DeactivateUserResponse{
+		User: userToPb(user),
+	}), nil
+}
+
+func (h *InternalServiceHandler) MergeUsers(ctx context.Context, req *connect.Request[internalapiv1.MergeUsersRequest]) (*connect.Response[internalapiv1.MergeUsersResponse], error) {
+	br := &badRequest{}
+	if req.Msg.TargetUserId <= 0 {
+		br.add("target_user_id", ErrInvalidUserID)
+	}
+
+	if req.Msg.SourceUserId <= 0 {
+		br.add("source_user_id", ErrInvalidUserID)
+	}
+
+	if !br.empty() {
+		return nil, br.err()
+	}
+
+	merge, err := h.users.MergeUsers(ctx, models.UserID(req.Msg.TargetUserId), models.UserID(req.Msg.SourceUserId), req.Msg.DryRun)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&internalapiv1.MergeUsersResponse{
+		MergeId:            merge.ID,
+		EntriesMoved:       merge.EntriesMoved,
+		EntriesDropped:     merge.EntriesDropped,
+		SettlementsMoved:   merge.SettlementsMoved,
+		SettlementsDropped: merge.SettlementsDropped,
+		BillsRewritten:     merge.BillsRewritten,
+		RevisionsRewritten: merge.RevisionsRewritten,
+		MembershipsMoved:   merge.MembershipsMoved,
+		MembershipsMerged:  merge.MembershipsMerged,
+	}), nil
+}
+
+func userToPb(user models.User) *internalapiv1.User {
+	res := &internalapiv1.User{
+		Id:          int64(user.ID),
+		Title:       user.Title,
+		Placeholder: user.Placeholder,
+		ClaimedBy:   int64(user.ClaimedBy),
+		MergedInto:  int64(user.MergedInto),
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+	}
+
+	if !user.External.IsZero() {
+		res.External = &internalapiv1.ExternalIdentity{
+			Provider: user.External.Provider,
+			Subject:  user.External.Subject,
+		}
+	}
+
+	if !user.DeactivatedAt.IsZero() {
+		res.DeactivatedAt = timestamppb.New(user.DeactivatedAt)
+	}
+
+	return res
+}
@@ -0,0 +1,147 @@
+package connect_handlers
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	invitev1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/invite/v1"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/invite/v1/invitev1connect"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InviteServiceHandler struct {
+	invitev1connect.UnimplementedInviteServiceHandler
+	service *services.InviteService
+	groups  *services.GroupService
+	policy  *authz.Policy
+}
+
+func NewInviteServiceHandler(service *services.InviteService, groups *services.GroupService, policy *authz.Policy) *InviteServiceHandler {
+	return &InviteServiceHandler{
+		service: service,
+		groups:  groups,
+		policy:  policy,
+	}
+}
+
+func (h *InviteServiceHandler) CreatePlaceholder(ctx context.Context, req *connect.Request[invitev1.CreatePlaceholderRequest]) (*connect.Response[invitev1.CreatePlaceholderResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	groupID := models.GroupID(req.Msg.GroupId)
+	if groupID != 0 {
+		group, err := h.groups.GetGroup(ctx, groupID)
+		if err != nil {
+			return nil, errorToConnect(err)
+		}
+
+		if err := h.policy.CanManageGroupMember(userID, group, models.GroupMember{Role: models.GroupRoleMember}); err != nil {
+			return nil, errorToConnect(err)
+		}
+	}
+
+	placeholder, invite, err := h.service.CreatePlaceholder(ctx, userID, req.Msg.Title, groupID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&invitev1.CreatePlaceholderResponse{
+		PlaceholderId: int64(placeholder.ID),
+		Invite:        h.inviteToPb(invite),
+	}), nil
+}
+
+func (h *InviteServiceHandler) CreateInvite(ctx context.Context, req *connect.Request[invitev1.CreateInviteRequest]) (*connect.Response[invitev1.CreateInviteResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	invite := models.Invite{
+		GroupID:       models.GroupID(req.Msg.GroupId),
+		PlaceholderID: models.UserID(req.Msg.PlaceholderId),
+	}
+
+	var group models.Group
+	if invite.GroupID != 0 {
+		if group, err = h.groups.GetGroup(ctx, invite.GroupID); err != nil {
+			return nil, errorToConnect(err)
+		}
+	}
+
+	var placeholder models.User
+	if invite.PlaceholderID != 0 {
+		if placeholder, err = h.service.GetUser(ctx, invite.PlaceholderID); err != nil {
+			return nil, errorToConnect(err)
+		}
+	}
+
+	if err := h.policy.CanCreateInvite(userID, invite, group, placeholder); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	invite, err = h.service.CreateInvite(ctx, userID, invite.GroupID, invite.PlaceholderID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&invitev1.CreateInviteResponse{
+		Invite: h.inviteToPb(invite),
+	}), nil
+}
+
+// Посмотреть приглашение может любой, у кого есть код.
+func (h *InviteServiceHandler) GetInvite(ctx context.Context, req *connect.Request[invitev1.GetInviteRequest]) (*connect.Response[invitev1.GetInviteResponse], error) {
+	if _, err := userIDFromCtx(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	invite, err := h.service.GetInvite(ctx, models.NormalizeInviteCode(req.Msg.Code))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&invitev1.GetInviteResponse{
+		Invite: h.inviteToPb(invite),
+	}), nil
+}
+
+func (h *InviteServiceHandler) ClaimInvite(ctx context.Context, req *connect.Request[invitev1.ClaimInviteRequest]) (*connect.Response[invitev1.ClaimInviteResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	invite, err := h.service.ClaimInvite(ctx, userID, models.NormalizeInviteCode(req.Msg.Code))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&invitev1.ClaimInviteResponse{
+		Invite: h.inviteToPb(invite),
+	}), nil
+}
+
+func (h *InviteServiceHandler) inviteToPb(invite models.Invite) *invitev1.Invite {
+	res := &invitev1.Invite{
+		Code:          string(invite.Code),
+		Link:          h.service.InviteLink(invite),
+		GroupId:       int64(invite.GroupID),
+		PlaceholderId: int64(invite.PlaceholderID),
+		CreatedBy:     int64(invite.CreatedBy),
+		CreatedAt:     timestamppb.New(invite.CreatedAt),
+		ExpiresAt:     timestamppb.New(invite.ExpiresAt),
+		ClaimedBy:     int64(invite.ClaimedBy),
+	}
+
+	if invite.ClaimedBy != 0 {
+		res.ClaimedAt = timestamppb.New(invite.ClaimedAt)
+	}
+
+	return res
+}
@@ -0,0 +1,53 @@
+package connect_handlers
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+)
+
+func normalizePageSize(pageSize uint32) uint64 {
+	switch {
+	case pageSize == 0:
+		return defaultPageSize
+	case pageSize > maxPageSize:
+		return maxPageSize
+	}
+
+	return uint64(pageSize)
+}
+
+// Курсор - ID последней записи на странице. Для клиента токен непрозрачный.
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(strconv.FormatInt(lastID, 10)),
+	)
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.Wrap(ErrInvalidPageToken, err.Error())
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return id, nil
+}
@@ -0,0 +1,187 @@
+package connect_handlers
+
+import (
+	"context"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	"github.com/SlamJam/dolgovnya-backend/internal/converter"
+	settlementv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/settlement/v1"
+	"github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/settlement/v1/settlementv1connect"
+	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SettlementServiceHandler struct {
+	settlementv1connect.UnimplementedSettlementServiceHandler
+	service *services.SettlementService
+	users   *services.UserService
+	policy  *authz.Policy
+}
+
+func NewSettlementServiceHandler(service *services.SettlementService, users *services.UserService, policy *authz.Policy) *SettlementServiceHandler {
+	return &SettlementServiceHandler{
+		service: service,
+		users:   users,
+		policy:  policy,
+	}
+}
+
+func (h *SettlementServiceHandler) GetSettlementPlan(ctx context.Context, req *connect.Request[settlementv1.GetSettlementPlanRequest]) (*connect.Response[settlementv1.GetSettlementPlanResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	br := &badRequest{}
+	userIDs := make([]models.UserID, 0, len(req.Msg.UserIds))
+	for i, id := range req.Msg.UserIds {
+		if id <= 0 {
+			br.add(indexPath("user_ids", i), ErrInvalidUserID)
+		}
+
+		userIDs = append(userIDs, models.UserID(id))
+	}
+
+	if !br.empty() {
+		return nil, br.err()
+	}
+
+	contacts, err := h.users.GetContacts(ctx, userID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	if err := h.policy.CanViewSettlementPlan(userID, userIDs, contacts); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	plan, err := h.service.GetSettlementPlan(ctx, userIDs)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&settlementv1.GetSettlementPlanResponse{
+		Transfers: transfersToPb(plan),
+	}), nil
+}
+
+func transfersToPb(invoices []models.Invoice) []*settlementv1.Transfer {
+	res := make([]*settlementv1.Transfer, 0, len(invoices))
+	for _, invoice := range invoices {
+		// UserFrom в Invoice - тот, кому должны.
+		res = append(res, &settlementv1.Transfer{
+			PayerId: int64(invoice.UserTo),
+			PayeeId: int64(invoice.UserFrom),
+			Amount:  converter.MoneyToPb(invoice.Value, invoice.Currency),
+		})
+	}
+
+	return res
+}
+
+func (h *SettlementServiceHandler) RecordSettlement(ctx context.Context, req *connect.Request[settlementv1.RecordSettlementRequest]) (*connect.Response[settlementv1.RecordSettlementResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	br := &badRequest{}
+	if req.Msg.PayerId <= 0 {
+		br.add("payer_id", ErrInvalidUserID)
+	}
+
+	if req.Msg.PayeeId <= 0 {
+		br.add("payee_id", ErrInvalidUserID)
+	}
+
+	currencyCode := req.Msg.CurrencyCode
+	if currencyCode == "" && req.Msg.Amount != nil {
+		currencyCode = req.Msg.Amount.CurrencyCode
+	}
+
+	currency, err := converter.CurrencyFromPb(currencyCode)
+	if err != nil {
+		br.add("currency_code", err)
+	}
+
+	var amount *models.Money
+	if req.Msg.Amount != nil {
+		m, err := converter.MoneyFromPb(req.Msg.Amount, currency)
+		if err != nil {
+			br.add("amount", err)
+		}
+		amount = &m
+	}
+
+	if !br.empty() {
+		return nil, br.err()
+	}
+
+	payerID, payeeID := models.UserID(req.Msg.PayerId), models.UserID(req.Msg.PayeeId)
+	if err := h.policy.CanRecordSettlement(userID, models.Settlement{PayerID: payerID, PayeeID: payeeID}); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	settlement, remaining, err := h.service.RecordSettlement(ctx, userID, payerID, payeeID, currency, amount)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	return connect.NewResponse(&settlementv1.RecordSettlementResponse{
+		Settlement:    settlementToPb(settlement),
+		RemainingDebt: converter.MoneyToPb(remaining, settlement.Currency),
+	}), nil
+}
+
+func (h *SettlementServiceHandler) ListSettlements(ctx context.Context, req *connect.Request[settlementv1.ListSettlementsRequest]) (*connect.Response[settlementv1.ListSettlementsResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	beforeID, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := normalizePageSize(req.Msg.PageSize)
+	settlements, err := h.service.ListSettlements(ctx, userID, models.SettlementListFilter{
+		CounterpartyID: models.UserID(req.Msg.CounterpartyId),
+		BeforeID:       models.SettlementID(beforeID),
+		// Берём на один больше, чтобы понять, есть ли следующая страница.
+		Limit: pageSize + 1,
+	})
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	var nextPageToken string
+	if uint64(len(settlements)) > pageSize {
+		settlements = settlements[:pageSize]
+		nextPageToken = encodePageToken(int64(settlements[len(settlements)-1].ID))
+	}
+
+	res := &settlementv1.ListSettlementsResponse{
+		Settlements:   make([]*settlementv1.Settlement, 0, len(settlements)),
+		NextPageToken: nextPageToken,
+	}
+	for _, settlement := range settlements {
+		res.Settlements = append(res.Settlements, settlementToPb(settlement))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func settlementToPb(settlement models.Settlement) *settlementv1.Settlement {
+	return &settlementv1.Settlement{
+		Id:         uint64(settlement.ID),
+		RecordedBy: int64(settlement.RecordedBy),
+		PayerId:    int64(settlement.PayerID),
+		PayeeId:    int64(settlement.PayeeID),
+		Amount:     converter.MoneyToPb(settlement.Amount, settlement.Currency),
+		CreatedAt:  timestamppb.New(settlement.CreatedAt),
+		GroupId:    int64(settlement.GroupID),
+	}
+}
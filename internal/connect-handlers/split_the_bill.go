@@ -3,6 +3,7 @@ package connect_handlers
 import (
 	"context"
 
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
 	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
 	split_the_billv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/split_the_bill/v1"
@@ -13,57 +14,77 @@ import (
 type SplitTheBillServiceHandler struct {
 	split_the_billv1connect.UnimplementedSplitTheBillServiceHandler
 	service *services.SplitTheBillService
+	groups  *services.GroupService
+	users   *services.UserService
+	policy  *authz.Policy
 }
 
-func NewSplitTheBillServiceHandler() *SplitTheBillServiceHandler {
-	return &SplitTheBillServiceHandler{}
+func NewSplitTheBillServiceHandler(service *services.SplitTheBillService, groups *services.GroupService, users *services.UserService, policy *authz.Policy) *SplitTheBillServiceHandler {
+	return &SplitTheBillServiceHandler{
+		service: service,
+		groups:  groups,
+		users:   users,
+		policy:  policy,
+	}
+}
+
+// Счета группы читают все её участники, а не только участники счёта.
+func (h *SplitTheBillServiceHandler) canReadBill(ctx context.Context, userID models.UserID, bill models.Bill) error {
+	err := h.policy.CanReadBill(userID, bill)
+	if err == nil || bill.GroupID == 0 {
+		return err
+	}
+
+	group, groupErr := h.groups.GetGroup(ctx, bill.GroupID)
+	if groupErr != nil {
+		return groupErr
+	}
+
+	if h.policy.CanViewGroup(userID, group) != nil {
+		return err
+	}
+
+	return nil
 }
 
-// var rules := NewRules(rulez.AUTHZ)
-func (h *SplitTheBillServiceHandler) NewBillSplit(ctx context.Context, req *connect.Request[split_the_billv1.NewBillRequest]) (*connect.Response[split_the_billv1.NewBillResponse], error) {
-	userID, err := userIDFromRequest(req)
+func (h *SplitTheBillServiceHandler) NewBill(ctx context.Context, req *connect.Request[split_the_billv1.NewBillRequest]) (*connect.Response[split_the_billv1.NewBillResponse], error) {
+	userID, err := userIDFromCtx(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
-	// TODO:
-	// err := checkPermissions(userID, rules)
-	// if err != nil {
-	// 	return nil, connect.NewError(connect.CodePermissionDenied, err)
-	// }
-
 	// DTO -> domain model
-	bill := models.Bill{}
-	bill.Items = make([]models.BillItem, 0, len(req.Msg.Items))
-	for _, item := range req.Msg.Items {
-		billItem := models.BillItem{
-			Title: item.Title,
-			// PricePerOne: ,
-			// Quantity: item.Quantity.,
-			// Type: uint8(item.Type), // if item.Type > 255 raise
-		}
-		for _, share := range item.Shares {
-			// _ = share
-			billItem.Shares = append(billItem.Shares, models.BillShare{
-				UserID: models.UserID(share.UserId),
-				Share:  uint32(share.Share),
-			})
-		}
+	bill, br := billFromPb(req.Msg)
+	if !br.empty() {
+		return nil, br.err()
+	}
 
-		bill.Items = append(bill.Items, billItem)
+	if err := bill.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	for _, payment := range req.Msg.Payments {
-		bill.Payments = append(bill.Payments, models.BillPayment{
-			UserID: models.UserID(payment.UserId),
-			// Amount: payment.Amount,
-		})
+	bill.GroupID = models.GroupID(req.Msg.GroupId)
+	if bill.GroupID == 0 {
+		var contacts authz.Contacts
+		if contacts, err = h.users.GetContacts(ctx, userID); err == nil {
+			err = h.policy.CanCreateBill(userID, bill, contacts)
+		}
+	} else {
+		var group models.Group
+		if group, err = h.groups.GetGroup(ctx, bill.GroupID); err == nil {
+			if err = h.policy.CanCreateGroupBill(userID, group, bill); err == nil {
+				err = group.CheckBillMembers(bill)
+			}
+		}
+	}
+	if err != nil {
+		return nil, errorToConnect(err)
 	}
 
 	billID, err := h.service.SaveBill(ctx, userID, bill)
 
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, errorToConnect(err)
 	}
 
 	resp := connect.NewResponse(&split_the_billv1.NewBillResponse{
@@ -72,3 +93,208 @@ func (h *SplitTheBillServiceHandler) NewBillSplit(ctx context.Context, req *conn
 
 	return resp, nil
 }
+
+func (h *SplitTheBillServiceHandler) GetBill(ctx context.Context, req *connect.Request[split_the_billv1.GetBillRequest]) (*connect.Response[split_the_billv1.GetBillResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	bill, err := h.service.GetBill(ctx, models.BillID(req.Msg.BillId))
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	if err := h.canReadBill(ctx, userID, bill); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	pbBill, err := billToPb(bill)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&split_the_billv1.GetBillResponse{
+		Bill: pbBill,
+	}), nil
+}
+
+func (h *SplitTheBillServiceHandler) ListBills(ctx context.Context, req *connect.Request[split_the_billv1.ListBillsRequest]) (*connect.Response[split_the_billv1.ListBillsResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	beforeID, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := normalizePageSize(req.Msg.PageSize)
+	filter := models.BillListFilter{
+		ParticipantID: models.UserID(req.Msg.ParticipantId),
+		GroupID:       models.GroupID(req.Msg.GroupId),
+		BeforeID:      models.BillID(beforeID),
+		// Берём на один больше, чтобы понять, есть ли следующая страница.
+		Limit: pageSize + 1,
+	}
+
+	if req.Msg.CreatedFrom != nil {
+		filter.CreatedFrom = req.Msg.CreatedFrom.AsTime()
+	}
+
+	if req.Msg.CreatedTo != nil {
+		filter.CreatedTo = req.Msg.CreatedTo.AsTime()
+	}
+
+	if filter.GroupID != 0 {
+		group, err := h.groups.GetGroup(ctx, filter.GroupID)
+		if err != nil {
+			return nil, errorToConnect(err)
+		}
+
+		if err := h.policy.CanViewGroup(userID, group); err != nil {
+			return nil, errorToConnect(err)
+		}
+	}
+
+	bills, err := h.service.ListBills(ctx, userID, filter)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	var nextPageToken string
+	if uint64(len(bills)) > pageSize {
+		bills = bills[:pageSize]
+		nextPageToken = encodePageToken(int64(bills[len(bills)-1].ID))
+	}
+
+	pbBills, err := billsToPb(bills)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&split_the_billv1.ListBillsResponse{
+		Bills:         pbBills,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (h *SplitTheBillServiceHandler) DeleteBill(ctx context.Context, req *connect.Request[split_the_billv1.DeleteBillRequest]) (*connect.Response[split_the_billv1.DeleteBillResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	billID := models.BillID(req.Msg.BillId)
+	bill, err := h.service.GetBill(ctx, billID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	if err := h.policy.CanDeleteBill(userID, bill); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	bill, err = h.service.DeleteBill(ctx, billID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	pbBill, err := billToPb(bill)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&split_the_billv1.DeleteBillResponse{
+		Bill: pbBill,
+	}), nil
+}
+
+func (h *SplitTheBillServiceHandler) UpdateBill(ctx context.Context, req *connect.Request[split_the_billv1.UpdateBillRequest]) (*connect.Response[split_the_billv1.UpdateBillResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	updated, br := billFromPb(req.Msg)
+	if !br.empty() {
+		return nil, br.err()
+	}
+
+	if err := updated.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	billID := models.BillID(req.Msg.BillId)
+	bill, err := h.service.GetBill(ctx, billID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	contacts, err := h.users.GetContacts(ctx, userID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	if err := h.policy.CanUpdateBill(userID, bill, updated, contacts); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	// Группу счёта при редактировании не меняем.
+	if bill.GroupID != 0 {
+		group, err := h.groups.GetGroup(ctx, bill.GroupID)
+		if err != nil {
+			return nil, errorToConnect(err)
+		}
+
+		if err := group.CheckBillMembers(updated); err != nil {
+			return nil, errorToConnect(err)
+		}
+	}
+
+	bill, err = h.service.UpdateBill(ctx, userID, billID, int(req.Msg.Revision), updated)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	pbBill, err := billToPb(bill)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&split_the_billv1.UpdateBillResponse{
+		Bill: pbBill,
+	}), nil
+}
+
+func (h *SplitTheBillServiceHandler) GetBillHistory(ctx context.Context, req *connect.Request[split_the_billv1.GetBillHistoryRequest]) (*connect.Response[split_the_billv1.GetBillHistoryResponse], error) {
+	userID, err := userIDFromCtx(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	billID := models.BillID(req.Msg.BillId)
+	bill, err := h.service.GetBill(ctx, billID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	if err := h.canReadBill(ctx, userID, bill); err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	history, err := h.service.GetBillHistory(ctx, billID)
+	if err != nil {
+		return nil, errorToConnect(err)
+	}
+
+	revisions, err := billHistoryToPb(history)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&split_the_billv1.GetBillHistoryResponse{
+		Revisions: revisions,
+	}), nil
+}
@@ -0,0 +1,419 @@
+package connect_handlers
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/converter"
+	split_the_billv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/split_the_bill/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidUserID = errors.New("user_id must be positive")
+)
+
+var roundingFromPb = map[split_the_billv1.RoundingStrategy]models.RoundingStrategy{
+	split_the_billv1.RoundingStrategy_ROUNDING_STRATEGY_UNSPECIFIED:       "",
+	split_the_billv1.RoundingStrategy_ROUNDING_STRATEGY_LARGEST_REMAINDER: models.RoundingLargestRemainder,
+	split_the_billv1.RoundingStrategy_ROUNDING_STRATEGY_PAYER_ABSORBS:     models.RoundingPayerAbsorbs,
+	split_the_billv1.RoundingStrategy_ROUNDING_STRATEGY_OWNER_ABSORBS:     models.RoundingOwnerAbsorbs,
+	split_the_billv1.RoundingStrategy_ROUNDING_STRATEGY_ROUND_ROBIN:       models.RoundingRoundRobin,
+}
+
+var adjustmentKindFromPb = map[split_the_billv1.AdjustmentKind]models.AdjustmentKind{
+	split_the_billv1.AdjustmentKind_ADJUSTMENT_KIND_TIP:            models.AdjustmentTip,
+	split_the_billv1.AdjustmentKind_ADJUSTMENT_KIND_TAX:            models.AdjustmentTax,
+	split_the_billv1.AdjustmentKind_ADJUSTMENT_KIND_SERVICE_CHARGE: models.AdjustmentServiceCharge,
+	split_the_billv1.AdjustmentKind_ADJUSTMENT_KIND_DISCOUNT:       models.AdjustmentDiscount,
+}
+
+var adjustmentSplitFromPb = map[split_the_billv1.AdjustmentSplit]models.AdjustmentSplit{
+	split_the_billv1.AdjustmentSplit_ADJUSTMENT_SPLIT_UNSPECIFIED:  "",
+	split_the_billv1.AdjustmentSplit_ADJUSTMENT_SPLIT_PROPORTIONAL: models.AdjustmentSplitProportional,
+	split_the_billv1.AdjustmentSplit_ADJUSTMENT_SPLIT_EQUAL:        models.AdjustmentSplitEqual,
+}
+
+var shareModeFromPb = map[split_the_billv1.ShareMode]models.ShareMode{
+	split_the_billv1.ShareMode_SHARE_MODE_UNSPECIFIED: "",
+	split_the_billv1.ShareMode_SHARE_MODE_WEIGHT:      models.ShareModeWeight,
+	split_the_billv1.ShareMode_SHARE_MODE_AMOUNT:      models.ShareModeAmount,
+	split_the_billv1.ShareMode_SHARE_MODE_PERCENT:     models.ShareModePercent,
+	split_the_billv1.ShareMode_SHARE_MODE_REMAINDER:   models.ShareModeRemainder,
+}
+
+// Обратное отображение для enum'ов: значение модели -> значение API.
+func enumToPb[P comparable, M comparable](fromPb map[P]M, v M) P {
+	var unspecified P
+	for pb, m := range fromPb {
+		if m == v && pb != unspecified {
+			return pb
+		}
+	}
+
+	return unspecified
+}
+
+// DTO -> domain model
+
+// Содержимое счёта в NewBillRequest и UpdateBillRequest.
+type billContentPb interface {
+	GetItems() []*split_the_billv1.BillItem
+	GetPayments() []*split_the_billv1.BillPayment
+	GetCurrencyCode() string
+	GetRounding() split_the_billv1.RoundingStrategy
+	GetAdjustments() []*split_the_billv1.BillAdjustment
+	GetQuantityPrecision() int32
+}
+
+func billFromPb(req billContentPb) (models.Bill, *badRequest) {
+	br := &badRequest{}
+	items, payments := req.GetItems(), req.GetPayments()
+
+	currency, err := converter.CurrencyFromPb(req.GetCurrencyCode())
+	if err != nil {
+		br.add("currency_code", err)
+	}
+
+	rounding, ok := roundingFromPb[req.GetRounding()]
+	if !ok {
+		br.add("rounding", models.ErrUnknownRoundingStrategy)
+	}
+
+	bill := models.Bill{Currency: currency, Rounding: rounding, QuantityPrecision: req.GetQuantityPrecision()}
+	bill.Items = make([]models.BillItem, 0, len(items))
+	for i, item := range items {
+		bill.Items = append(bill.Items, billItemFromPb(br, i, item, currency))
+	}
+
+	bill.Adjustments = make([]models.BillAdjustment, 0, len(req.GetAdjustments()))
+	for i, adjustment := range req.GetAdjustments() {
+		bill.Adjustments = append(bill.Adjustments, billAdjustmentFromPb(br, i, adjustment, currency))
+	}
+
+	bill.Payments = make([]models.BillPayment, 0, len(payments))
+	for i, payment := range payments {
+		if payment.UserId <= 0 {
+			br.add(fieldPath("payments", i, "user_id"), ErrInvalidUserID)
+		}
+
+		amount, err := converter.MoneyFromPb(payment.Amount, currency)
+		if err != nil {
+			br.add(fieldPath("payments", i, "amount"), err)
+		}
+
+		bill.Payments = append(bill.Payments, models.BillPayment{
+			UserID: models.UserID(payment.UserId),
+			Amount: amount,
+		})
+	}
+
+	return bill, br
+}
+
+func billAdjustmentFromPb(br *badRequest, i int, adjustment *split_the_billv1.BillAdjustment, currency models.Currency) models.BillAdjustment {
+	res := models.BillAdjustment{
+		Title: adjustment.Title,
+	}
+
+	var ok bool
+	if res.Kind, ok = adjustmentKindFromPb[adjustment.Kind]; !ok {
+		br.add(fieldPath("adjustments", i, "kind"), models.ErrUnknownAdjustmentKind)
+	}
+
+	if res.Split, ok = adjustmentSplitFromPb[adjustment.Split]; !ok {
+		br.add(fieldPath("adjustments", i, "split"), models.ErrUnknownAdjustmentSplit)
+	}
+
+	var err error
+	switch value := adjustment.Value.(type) {
+	case *split_the_billv1.BillAdjustment_Percent:
+		if res.Percent, err = converter.DecimalFromPb(value.Percent); err != nil {
+			br.add(fieldPath("adjustments", i, "percent"), err)
+		}
+	case *split_the_billv1.BillAdjustment_Amount:
+		if res.Amount, err = converter.MoneyFromPb(value.Amount, currency); err != nil {
+			br.add(fieldPath("adjustments", i, "amount"), err)
+		}
+	default:
+		br.add(fieldPath("adjustments", i, "value"), models.ErrAdjustmentValueNotOneOf)
+	}
+
+	usersPath := fmt.Sprintf("adjustments[%d].user_ids", i)
+	res.UserIDs = make([]models.UserID, 0, len(adjustment.UserIds))
+	for j, userID := range adjustment.UserIds {
+		if userID <= 0 {
+			br.add(indexPath(usersPath, j), ErrInvalidUserID)
+		}
+
+		res.UserIDs = append(res.UserIDs, models.UserID(userID))
+	}
+
+	return res
+}
+
+func billItemFromPb(br *badRequest, i int, item *split_the_billv1.BillItem, currency models.Currency) models.BillItem {
+	billItem := models.BillItem{
+		Title: item.Title,
+	}
+
+	var err error
+	if billItem.PricePerOne, err = converter.MoneyFromPb(item.PricePerOne, currency); err != nil {
+		br.add(fieldPath("items", i, "price_per_one"), err)
+	}
+
+	if billItem.Quantity, err = converter.DecimalFromPb(item.Quantity); err != nil {
+		br.add(fieldPath("items", i, "quantity"), err)
+	}
+
+	if item.Type < 0 || item.Type > math.MaxUint8 {
+		br.add(fieldPath("items", i, "type"), converter.ErrOutOfRange)
+	}
+	billItem.Type = uint8(item.Type)
+
+	sharesPath := fmt.Sprintf("items[%d].shares", i)
+	billItem.Shares = make([]models.BillShare, 0, len(item.Shares))
+	for j, share := range item.Shares {
+		if share.UserId <= 0 {
+			br.add(fieldPath(sharesPath, j, "user_id"), ErrInvalidUserID)
+		}
+
+		if share.Share > math.MaxUint32 {
+			br.add(fieldPath(sharesPath, j, "share"), converter.ErrOutOfRange)
+		}
+
+		billShare := models.BillShare{
+			UserID: models.UserID(share.UserId),
+			Share:  uint32(share.Share),
+		}
+
+		var ok bool
+		if billShare.Mode, ok = shareModeFromPb[share.Mode]; !ok {
+			br.add(fieldPath(sharesPath, j, "mode"), models.ErrUnknownShareMode)
+		}
+
+		if share.Amount != nil {
+			amount, err := converter.MoneyFromPb(share.Amount, currency)
+			if err != nil {
+				br.add(fieldPath(sharesPath, j, "amount"), err)
+			}
+			billShare.Amount = &amount
+		}
+
+		if share.Percent != nil {
+			percent, err := converter.DecimalFromPb(share.Percent)
+			if err != nil {
+				br.add(fieldPath(sharesPath, j, "percent"), err)
+			}
+			billShare.Percent = &percent
+		}
+
+		billItem.Shares = append(billItem.Shares, billShare)
+	}
+
+	return billItem
+}
+
+// domain model -> DTO
+
+func billToPb(bill models.Bill) (*split_the_billv1.Bill, error) {
+	invoices, err := bill.ToInvoices()
+	if err != nil {
+		return nil, err
+	}
+
+	currency := bill.GetCurrency()
+	res := &split_the_billv1.Bill{
+		Id:                uint64(bill.ID),
+		OwnerId:           int64(bill.OwnerID),
+		CreatedAt:         timestamppb.New(bill.CreatedAt),
+		Items:             make([]*split_the_billv1.BillItem, 0, len(bill.Items)),
+		Payments:          make([]*split_the_billv1.BillPayment, 0, len(bill.Payments)),
+		Invoices:          make([]*split_the_billv1.Invoice, 0, len(invoices)),
+		CurrencyCode:      currency.String(),
+		Rounding:          enumToPb(roundingFromPb, bill.Rounding.OrDefault()),
+		Adjustments:       make([]*split_the_billv1.BillAdjustment, 0, len(bill.Adjustments)),
+		QuantityPrecision: bill.GetQuantityPrecision(),
+		Revision:          uint32(bill.Revision),
+		GroupId:           int64(bill.GroupID),
+	}
+
+	for _, item := range bill.Items {
+		res.Items = append(res.Items, billItemToPb(item, currency))
+	}
+
+	for _, adjustment := range bill.Adjustments {
+		res.Adjustments = append(res.Adjustments, billAdjustmentToPb(adjustment, currency))
+	}
+
+	for _, payment := range bill.Payments {
+		res.Payments = append(res.Payments, &split_the_billv1.BillPayment{
+			UserId: int64(payment.UserID),
+			Amount: converter.MoneyToPb(payment.Amount, currency),
+		})
+	}
+
+	for _, invoice := range invoices {
+		res.Invoices = append(res.Invoices, &split_the_billv1.Invoice{
+			UserFrom: int64(invoice.UserFrom),
+			UserTo:   int64(invoice.UserTo),
+			Amount:   converter.MoneyToPb(invoice.Value, invoice.Currency),
+		})
+	}
+
+	return res, nil
+}
+
+func billAdjustmentToPb(adjustment models.BillAdjustment, currency models.Currency) *split_the_billv1.BillAdjustment {
+	res := &split_the_billv1.BillAdjustment{
+		Title:   adjustment.Title,
+		Kind:    enumToPb(adjustmentKindFromPb, adjustment.Kind),
+		Split:   enumToPb(adjustmentSplitFromPb, adjustment.GetSplit()),
+		UserIds: make([]int64, 0, len(adjustment.UserIDs)),
+	}
+
+	if !adjustment.Percent.IsZero() {
+		res.Value = &split_the_billv1.BillAdjustment_Percent{Percent: converter.DecimalToPb(adjustment.Percent)}
+	} else {
+		res.Value = &split_the_billv1.BillAdjustment_Amount{Amount: converter.MoneyToPb(adjustment.Amount, currency)}
+	}
+
+	for _, userID := range adjustment.UserIDs {
+		res.UserIds = append(res.UserIds, int64(userID))
+	}
+
+	return res
+}
+
+func billItemToPb(item models.BillItem, currency models.Currency) *split_the_billv1.BillItem {
+	res := &split_the_billv1.BillItem{
+		Title:       item.Title,
+		PricePerOne: converter.MoneyToPb(item.PricePerOne, currency),
+		Quantity:    converter.DecimalToPb(item.Quantity),
+		Type:        int64(item.Type),
+		Shares:      make([]*split_the_billv1.BillShare, 0, len(item.Shares)),
+	}
+
+	for _, share := range item.Shares {
+		res.Shares = append(res.Shares, billShareToPb(share, currency))
+	}
+
+	return res
+}
+
+func billShareToPb(share models.BillShare, currency models.Currency) *split_the_billv1.BillShare {
+	res := &split_the_billv1.BillShare{
+		UserId: int64(share.UserID),
+		Share:  uint64(share.Share),
+		Mode:   enumToPb(shareModeFromPb, share.GetMode()),
+	}
+
+	if share.Amount != nil {
+		res.Amount = converter.MoneyToPb(*share.Amount, currency)
+	}
+
+	if share.Percent != nil {
+		res.Percent = converter.DecimalToPb(*share.Percent)
+	}
+
+	return res
+}
+
+func billsToPb(bills []models.Bill) ([]*split_the_billv1.Bill, error) {
+	res := make([]*split_the_billv1.Bill, 0, len(bills))
+	for _, bill := range bills {
+		pbBill, err := billToPb(bill)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, pbBill)
+	}
+
+	return res, nil
+}
+
+func billHistoryToPb(history []models.BillHistoryEntry) ([]*split_the_billv1.BillRevision, error) {
+	res := make([]*split_the_billv1.BillRevision, 0, len(history))
+
+	// Суммы старой стороны диффа - в валюте предыдущей версии.
+	prevCurrency := models.DefaultCurrency
+	for _, entry := range history {
+		pbBill, err := billToPb(entry.Bill)
+		if err != nil {
+			return nil, err
+		}
+
+		currency := entry.Bill.GetCurrency()
+		res = append(res, &split_the_billv1.BillRevision{
+			Revision:  uint32(entry.Revision),
+			EditorId:  int64(entry.EditorID),
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+			Bill:      pbBill,
+			Diff:      billDiffToPb(entry.Diff, prevCurrency, currency),
+		})
+		prevCurrency = currency
+	}
+
+	return res, nil
+}
+
+func billDiffToPb(diff models.BillDiff, oldCurrency, currency models.Currency) *split_the_billv1.BillDiff {
+	res := &split_the_billv1.BillDiff{
+		ItemsAdded:     make([]*split_the_billv1.BillItem, 0, len(diff.ItemsAdded)),
+		ItemsRemoved:   make([]*split_the_billv1.BillItem, 0, len(diff.ItemsRemoved)),
+		ItemsChanged:   make([]*split_the_billv1.BillItemChange, 0, len(diff.ItemsChanged)),
+		BalanceChanges: make([]*split_the_billv1.BalanceChange, 0, len(diff.BalanceChanges)),
+	}
+
+	for _, item := range diff.ItemsAdded {
+		res.ItemsAdded = append(res.ItemsAdded, billItemToPb(item, currency))
+	}
+
+	for _, item := range diff.ItemsRemoved {
+		res.ItemsRemoved = append(res.ItemsRemoved, billItemToPb(item, oldCurrency))
+	}
+
+	for _, change := range diff.ItemsChanged {
+		pbChange := &split_the_billv1.BillItemChange{
+			Title:           change.Title,
+			Old:             billItemToPb(change.Old, oldCurrency),
+			New:             billItemToPb(change.New, currency),
+			PriceChanged:    change.PriceChanged(),
+			QuantityChanged: change.QuantityChanged(),
+			ShareChanges:    make([]*split_the_billv1.ShareChange, 0, len(change.ShareChanges)),
+		}
+
+		for _, sc := range change.ShareChanges {
+			pbShareChange := &split_the_billv1.ShareChange{UserId: int64(sc.UserID)}
+			if sc.Old != nil {
+				pbShareChange.Old = billShareToPb(*sc.Old, oldCurrency)
+			}
+			if sc.New != nil {
+				pbShareChange.New = billShareToPb(*sc.New, currency)
+			}
+
+			pbChange.ShareChanges = append(pbChange.ShareChanges, pbShareChange)
+		}
+
+		res.ItemsChanged = append(res.ItemsChanged, pbChange)
+	}
+
+	for _, bc := range diff.BalanceChanges {
+		pbChange := &split_the_billv1.BalanceChange{
+			UserId:          int64(bc.UserID),
+			Old:             converter.MoneyToPb(bc.Old, bc.OldCurrency),
+			New:             converter.MoneyToPb(bc.New, bc.Currency),
+			CurrencyChanged: bc.CurrencyChanged(),
+		}
+		if !bc.CurrencyChanged() {
+			pbChange.Delta = converter.MoneyToPb(bc.Delta(), bc.Currency)
+		}
+
+		res.BalanceChanges = append(res.BalanceChanges, pbChange)
+	}
+
+	return res
+}
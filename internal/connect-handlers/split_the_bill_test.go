@@ -0,0 +1,77 @@
+package connect_handlers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/auth"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/authz"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/config"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/app/services"
+	connect_handlers "github.com/SlamJam/dolgovnya-backend/internal/connect-handlers"
+	"github.com/SlamJam/dolgovnya-backend/internal/converter"
+	split_the_billv1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/split_the_bill/v1"
+	"github.com/bufbuild/connect-go"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+// Остальные методы хранилищ тесту не нужны.
+type memBillStorage struct {
+	services.SplitTheBillStorage
+	saveErr error
+}
+
+func (m *memBillStorage) SaveSplittedBill(ctx context.Context, userID models.UserID, bill models.Bill) (models.BillID, error) {
+	return 0, m.saveErr
+}
+
+type memContactStorage struct {
+	services.UserStorage
+	contacts []models.UserID
+}
+
+func (m *memContactStorage) GetUserContacts(ctx context.Context, userID models.UserID) ([]models.UserID, error) {
+	return m.contacts, nil
+}
+
+func TestNewBillSaveErrors(t *testing.T) {
+	require := require.New(t)
+
+	log := zerolog.Nop()
+	ctx := auth.WithUserID(context.Background(), 1)
+	req := connect.NewRequest(&split_the_billv1.NewBillRequest{
+		Items: []*split_the_billv1.BillItem{{
+			Title:       "Ужин",
+			PricePerOne: converter.MoneyToPb(models.Money{Decimal: decimal.NewFromInt(100)}, models.DefaultCurrency),
+			Quantity:    converter.DecimalToPb(decimal.NewFromInt(1)),
+			Shares:      []*split_the_billv1.BillShare{{UserId: 2, Share: 1}},
+		}},
+		Payments: []*split_the_billv1.BillPayment{{
+			UserId: 1,
+			Amount: converter.MoneyToPb(models.Money{Decimal: decimal.NewFromInt(100)}, models.DefaultCurrency),
+		}},
+	})
+
+	for _, tc := range []struct {
+		err  error
+		code connect.Code
+	}{
+		{errors.Wrapf(models.ErrUserMerged, "user 2"), connect.CodeFailedPrecondition},
+		{errors.Wrapf(models.ErrUserDeactivated, "user 2"), connect.CodeFailedPrecondition},
+		{errors.New("connection reset"), connect.CodeInternal},
+	} {
+		h := connect_handlers.NewSplitTheBillServiceHandler(
+			services.NewSplitTheBillService(&memBillStorage{saveErr: tc.err}),
+			nil,
+			services.NewUserService(&memContactStorage{contacts: []models.UserID{2}}, &log),
+			authz.NewPolicy(config.Config{}),
+		)
+
+		_, err := h.NewBill(ctx, req)
+		require.Equal(tc.code, connect.CodeOf(err), tc.err.Error())
+	}
+}
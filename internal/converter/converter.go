@@ -0,0 +1,103 @@
+// Преобразования между типами google.type.* из API и доменными моделями.
+package converter
+
+import (
+	"math/big"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	decimalpb "google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+const (
+	maxNanos = 999_999_999
+)
+
+var (
+	ErrEmptyValue       = errors.New("value is empty")
+	ErrNanosOutOfRange  = errors.Errorf("nanos must be between -%d and %d", maxNanos, maxNanos)
+	ErrNanosSign        = errors.New("units and nanos must have the same sign")
+	ErrOutOfRange       = errors.New("value is out of range")
+	ErrCurrencyMismatch = errors.New("currency_code doesn't match the expected currency")
+)
+
+var nanosInUnit = decimal.New(1, 9)
+
+// Пустой код - DefaultCurrency, как у клиентов до мультивалютности.
+func CurrencyFromPb(code string) (models.Currency, error) {
+	if code == "" {
+		return models.DefaultCurrency, nil
+	}
+
+	return models.ParseCurrency(code)
+}
+
+// Сумма в валюте currency. Пустой currency_code в m означает ту же валюту.
+func MoneyFromPb(m *money.Money, currency models.Currency) (models.Money, error) {
+	if m == nil {
+		return models.Money{}, ErrEmptyValue
+	}
+
+	if m.CurrencyCode != "" {
+		c, err := models.ParseCurrency(m.CurrencyCode)
+		if err != nil {
+			return models.Money{}, err
+		}
+
+		if c != currency.OrDefault() {
+			return models.Money{}, errors.Wrapf(ErrCurrencyMismatch, "%s instead of %s", c, currency)
+		}
+	}
+
+	if m.Nanos < -maxNanos || m.Nanos > maxNanos {
+		return models.Money{}, ErrNanosOutOfRange
+	}
+
+	if (m.Units > 0 && m.Nanos < 0) || (m.Units < 0 && m.Nanos > 0) {
+		return models.Money{}, ErrNanosSign
+	}
+
+	value := decimal.New(m.Units, 0).Add(decimal.New(int64(m.Nanos), -9))
+
+	// Доли минимальной единицы валюты не округляем молча.
+	rounded := value.Round(currency.Precision())
+	if !rounded.Equal(value) {
+		return models.Money{}, errors.Wrapf(models.ErrMoneyPrecision, "%s %s", value, currency)
+	}
+
+	return models.Money{Decimal: rounded}, nil
+}
+
+func MoneyToPb(m models.Money, currency models.Currency) *money.Money {
+	units := m.Truncate(0)
+
+	return &money.Money{
+		CurrencyCode: currency.String(),
+		Units:        units.IntPart(),
+		Nanos:        int32(m.Sub(units).Mul(nanosInUnit).IntPart()),
+	}
+}
+
+func DecimalFromPb(d *decimalpb.Decimal) (decimal.Decimal, error) {
+	if d == nil || d.Value == "" {
+		return decimal.Decimal{}, ErrEmptyValue
+	}
+
+	v, err := decimal.NewFromString(d.Value)
+	if err != nil {
+		return decimal.Decimal{}, errors.WithStack(err)
+	}
+
+	return v, nil
+}
+
+func DecimalToPb(d decimal.Decimal) *decimalpb.Decimal {
+	return &decimalpb.Decimal{Value: d.String()}
+}
+
+// Дробь, округлённая до digits знаков после запятой.
+func RatToPb(r *big.Rat, digits int) *decimalpb.Decimal {
+	return DecimalToPb(decimal.RequireFromString(r.FloatString(digits)))
+}
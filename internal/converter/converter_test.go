@@ -0,0 +1,91 @@
+package converter_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/converter"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	decimalpb "google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestMoneyFromPb(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		in    *money.Money
+		want  string
+		isErr error
+	}{
+		{name: "whole", in: &money.Money{Units: 100}, want: "100"},
+		{name: "cents", in: &money.Money{Units: 1, Nanos: 750_000_000}, want: "1.75"},
+		{name: "negative", in: &money.Money{Units: -1, Nanos: -750_000_000}, want: "-1.75"},
+		{name: "negative below one", in: &money.Money{Nanos: -10_000_000}, want: "-0.01"},
+		{name: "nil", in: nil, isErr: converter.ErrEmptyValue},
+		{name: "sign mismatch", in: &money.Money{Units: 1, Nanos: -1}, isErr: converter.ErrNanosSign},
+		{name: "nanos overflow", in: &money.Money{Nanos: 1_000_000_000}, isErr: converter.ErrNanosOutOfRange},
+		{name: "fraction of cent", in: &money.Money{Units: 1, Nanos: 5_000_000}, isErr: models.ErrMoneyPrecision},
+		{name: "same currency", in: &money.Money{CurrencyCode: "RUB", Units: 5}, want: "5"},
+		{name: "other currency", in: &money.Money{CurrencyCode: "USD", Units: 5}, isErr: converter.ErrCurrencyMismatch},
+		{name: "unknown currency", in: &money.Money{CurrencyCode: "XYZ", Units: 5}, isErr: models.ErrUnknownCurrency},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			m, err := converter.MoneyFromPb(tc.in, models.DefaultCurrency)
+			if tc.isErr != nil {
+				require.ErrorIs(err, tc.isErr)
+				return
+			}
+
+			require.NoError(err)
+			require.NoError(m.Validate(models.DefaultCurrency))
+			require.True(m.Equal(decimal.RequireFromString(tc.want)), "got %s", m)
+
+			// туда и обратно
+			pb := converter.MoneyToPb(m, models.DefaultCurrency)
+			require.Equal(tc.in.Units, pb.Units)
+			require.Equal(tc.in.Nanos, pb.Nanos)
+			require.Equal("RUB", pb.CurrencyCode)
+		})
+	}
+}
+
+func TestMoneyFromPbPrecision(t *testing.T) {
+	require := require.New(t)
+
+	_, err := converter.MoneyFromPb(&money.Money{Units: 100, Nanos: 500_000_000}, "JPY")
+	require.ErrorIs(err, models.ErrMoneyPrecision)
+
+	m, err := converter.MoneyFromPb(&money.Money{CurrencyCode: "KWD", Units: 1, Nanos: 125_000_000}, "KWD")
+	require.NoError(err)
+	require.True(m.Equal(decimal.RequireFromString("1.125")), "got %s", m)
+
+	c, err := converter.CurrencyFromPb("")
+	require.NoError(err)
+	require.Equal(models.DefaultCurrency, c)
+
+	_, err = converter.CurrencyFromPb("RUBLES")
+	require.ErrorIs(err, models.ErrUnknownCurrency)
+}
+
+func TestDecimalFromPb(t *testing.T) {
+	require := require.New(t)
+
+	d, err := converter.DecimalFromPb(&decimalpb.Decimal{Value: "1.5"})
+	require.NoError(err)
+	require.True(d.Equal(decimal.RequireFromString("1.5")))
+
+	_, err = converter.DecimalFromPb(&decimalpb.Decimal{Value: "abc"})
+	require.Error(err)
+}
+
+func TestRatToPb(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("0.006666666667", converter.RatToPb(big.NewRat(1, 150), 12).Value)
+	require.Equal("-0.5", converter.RatToPb(big.NewRat(-1, 2), 12).Value)
+	require.Equal("0", converter.RatToPb(new(big.Rat), 12).Value)
+}
@@ -0,0 +1,240 @@
+// Выгрузка отчётов в файлы: CSV, JSON и PDF.
+package exporter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown file format")
+	ErrUnknownReport = errors.New("unknown report")
+	ErrInvalidPeriod = errors.New("invalid report period")
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+	FormatPDF  = "pdf"
+)
+
+const (
+	// Проводки с нарастающим балансом.
+	ReportLedger = "ledger"
+	// Счета с позициями, долями, оплатами и долгами.
+	ReportBills = "bills"
+)
+
+// Всё, что известно об отчёте до первой строки.
+type ReportHeader struct {
+	// Имя файла без расширения.
+	Name     string
+	Title    string
+	Subtitle string
+	Columns  []string
+	// Поля документа JSON, кроме списка записей. Должен кодироваться в объект.
+	Document any
+	// Поле документа JSON со списком записей.
+	ListField string
+}
+
+// Часть отчёта в двух видах: строки таблицы для CSV и PDF и записи списка
+// документа для JSON.
+type ReportPage struct {
+	Rows    [][]string
+	Records []any
+}
+
+// Отчёт целиком, одной страницей.
+type Report struct {
+	ReportHeader
+	ReportPage
+}
+
+func NormalizeFormat(format string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case FormatCSV, FormatJSON, FormatPDF:
+		return format, nil
+	}
+
+	return "", errors.Wrapf(ErrUnknownFormat, "%q", format)
+}
+
+func NormalizeReport(kind string) (string, error) {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	switch kind {
+	case ReportLedger, ReportBills:
+		return kind, nil
+	}
+
+	return "", errors.Wrapf(ErrUnknownReport, "%q", kind)
+}
+
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatJSON:
+		return "application/json"
+	case FormatPDF:
+		return "application/pdf"
+	}
+
+	return "application/octet-stream"
+}
+
+// Шапка отчёта kind (ReportLedger или ReportBills).
+func Header(kind string, period Period) (ReportHeader, error) {
+	kind, err := NormalizeReport(kind)
+	if err != nil {
+		return ReportHeader{}, err
+	}
+
+	if kind == ReportBills {
+		return BillsHeader(period), nil
+	}
+
+	return LedgerHeader(period), nil
+}
+
+func FileName(report ReportHeader, format string) string {
+	return report.Name + "." + format
+}
+
+// Пишет отчёт по частям, по мере того как они читаются из базы: сначала
+// шапка, потом страницы, потом Close. Close дописывает конец файла, но
+// не закрывает w.
+type Writer interface {
+	WriteHeader(ReportHeader) error
+	WritePage(ReportPage) error
+	Close() error
+}
+
+func NewWriter(w io.Writer, format string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatJSON:
+		return &jsonWriter{w: w}, nil
+	case FormatPDF:
+		return &pdfWriter{w: w}, nil
+	}
+
+	return nil, errors.Wrapf(ErrUnknownFormat, "%q", format)
+}
+
+func Write(w io.Writer, format string, report Report) error {
+	writer, err := NewWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.WriteHeader(report.ReportHeader); err != nil {
+		return err
+	}
+
+	if err := writer.WritePage(report.ReportPage); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// Заголовок из Columns, дальше строки таблицы.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteHeader(header ReportHeader) error {
+	return c.write([][]string{header.Columns})
+}
+
+func (c *csvWriter) WritePage(page ReportPage) error {
+	return c.write(page.Rows)
+}
+
+func (c *csvWriter) write(rows [][]string) error {
+	for _, row := range rows {
+		if err := c.w.Write(row); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	c.w.Flush()
+
+	return errors.WithStack(c.w.Error())
+}
+
+func (c *csvWriter) Close() error {
+	return nil
+}
+
+// Тот же документ, что дал бы json.Encoder с отступом в два пробела, но
+// записи списка пишутся по одной.
+type jsonWriter struct {
+	w       io.Writer
+	records int
+}
+
+func (j *jsonWriter) WriteHeader(header ReportHeader) error {
+	head, err := json.MarshalIndent(header.Document, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	head = bytes.TrimSuffix(bytes.TrimRight(head, "\n"), []byte("}"))
+	if !bytes.HasPrefix(head, []byte("{")) {
+		return errors.Errorf("report document is not an object: %s", head)
+	}
+
+	if head = bytes.TrimRight(head, "\n"); len(head) > 1 {
+		head = append(head, ',')
+	}
+
+	field, err := json.Marshal(header.ListField)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = fmt.Fprintf(j.w, "%s\n  %s: [", head, field)
+
+	return errors.WithStack(err)
+}
+
+func (j *jsonWriter) WritePage(page ReportPage) error {
+	for _, record := range page.Records {
+		data, err := json.MarshalIndent(record, "    ", "  ")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		sep := ","
+		if j.records == 0 {
+			sep = ""
+		}
+		j.records++
+
+		if _, err := fmt.Fprintf(j.w, "%s\n    %s", sep, data); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	tail := "]\n}\n"
+	if j.records != 0 {
+		tail = "\n  ]\n}\n"
+	}
+
+	_, err := io.WriteString(j.w, tail)
+
+	return errors.WithStack(err)
+}
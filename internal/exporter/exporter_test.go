@@ -0,0 +1,203 @@
+package exporter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/exporter"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func money(v string) models.Money {
+	return models.Money{Decimal: decimal.RequireFromString(v)}
+}
+
+var period = exporter.Period{
+	UserID: 1,
+	From:   time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+	To:     time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func testBill() models.Bill {
+	return models.Bill{
+		ID:        10,
+		OwnerID:   1,
+		CreatedAt: time.Date(2023, 4, 2, 12, 0, 0, 0, time.UTC),
+		Revision:  1,
+		Items: []models.BillItem{
+			{
+				Title:       "Торт",
+				PricePerOne: money("300"),
+				Quantity:    decimal.NewFromInt(1),
+				Shares: []models.BillShare{
+					{UserID: 1, Share: 1},
+					{UserID: 2, Share: 2},
+				},
+			},
+		},
+		Payments: []models.BillPayment{{UserID: 1, Amount: money("300")}},
+	}
+}
+
+func TestLedgerReportCSV(t *testing.T) {
+	require := require.New(t)
+
+	report := exporter.LedgerReport(period, []models.StatementEntry{
+		{
+			EntryID:        5,
+			CreatedAt:      time.Date(2023, 4, 2, 12, 0, 0, 0, time.UTC),
+			Source:         models.StatementSourceBill,
+			BillID:         10,
+			CounterpartyID: 2,
+			Currency:       models.DefaultCurrency,
+			Amount:         money("200"),
+			Balance:        money("200"),
+		},
+		{
+			EntryID:        6,
+			CreatedAt:      time.Date(2023, 4, 3, 12, 0, 0, 0, time.UTC),
+			Source:         models.StatementSourceSettlement,
+			SettlementID:   3,
+			CounterpartyID: 2,
+			Currency:       models.DefaultCurrency,
+			Amount:         money("-50"),
+			Balance:        money("150"),
+		},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(exporter.Write(&buf, exporter.FormatCSV, report))
+	require.Equal(
+		"entry_id,created_at,source,bill_id,settlement_id,group_id,counterparty_id,currency,amount,balance\n"+
+			"5,2023-04-02T12:00:00Z,split_the_bill,10,,,2,RUB,200.00,200.00\n"+
+			"6,2023-04-03T12:00:00Z,settlement,,3,,2,RUB,-50.00,150.00\n",
+		buf.String(),
+	)
+	require.Equal("ledger-1.csv", exporter.FileName(report.ReportHeader, exporter.FormatCSV))
+}
+
+func TestBillsReportJSON(t *testing.T) {
+	require := require.New(t)
+
+	report, err := exporter.BillsReport(period, []models.Bill{testBill()})
+	require.NoError(err)
+
+	records := map[string]int{}
+	for _, row := range report.Rows {
+		records[row[4]]++
+	}
+	require.Equal(map[string]int{"item": 1, "share": 2, "payment": 1, "invoice": 1}, records)
+
+	var buf bytes.Buffer
+	require.NoError(exporter.Write(&buf, exporter.FormatJSON, report))
+
+	var doc struct {
+		UserID int64 `json:"user_id"`
+		Bills  []struct {
+			ID       int64 `json:"id"`
+			Total    string
+			Invoices []struct {
+				UserFrom int64 `json:"user_from"`
+				UserTo   int64 `json:"user_to"`
+				Amount   string
+			}
+		}
+	}
+	require.NoError(json.Unmarshal(buf.Bytes(), &doc))
+	require.Equal(int64(1), doc.UserID)
+	require.Len(doc.Bills, 1)
+	require.Equal("300.00", doc.Bills[0].Total)
+	require.Len(doc.Bills[0].Invoices, 1)
+	require.Equal(int64(1), doc.Bills[0].Invoices[0].UserFrom)
+	require.Equal(int64(2), doc.Bills[0].Invoices[0].UserTo)
+	require.Equal("200.00", doc.Bills[0].Invoices[0].Amount)
+}
+
+func TestWritePDF(t *testing.T) {
+	require := require.New(t)
+
+	bills := []models.Bill{}
+	for i := 0; i < 30; i++ {
+		bills = append(bills, testBill())
+	}
+
+	report, err := exporter.BillsReport(period, bills)
+	require.NoError(err)
+
+	var buf bytes.Buffer
+	require.NoError(exporter.Write(&buf, exporter.FormatPDF, report))
+	pdf := buf.String()
+
+	require.True(strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	require.True(strings.HasSuffix(pdf, "%%EOF\n"))
+	require.Contains(pdf, " Tort ")
+	require.Contains(pdf, "/Count 4")
+
+	// Каждая запись xref указывает на начало своего объекта.
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	require.NotNil(startxref)
+	offset, err := strconv.Atoi(startxref[1])
+	require.NoError(err)
+	require.True(strings.HasPrefix(pdf[offset:], "xref\n"))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf[offset:], -1)
+	require.Len(entries, 3+2*4)
+	for i, entry := range entries {
+		objOffset, err := strconv.Atoi(entry[1])
+		require.NoError(err)
+		require.True(strings.HasPrefix(pdf[objOffset:], strconv.Itoa(i+1)+" 0 obj\n"), "object %d", i+1)
+	}
+
+	_, err = exporter.NormalizeFormat("xlsx")
+	require.ErrorIs(err, exporter.ErrUnknownFormat)
+}
+
+func TestWriterPages(t *testing.T) {
+	require := require.New(t)
+
+	bills := []models.Bill{testBill(), testBill(), testBill()}
+	whole, err := exporter.BillsReport(period, bills)
+	require.NoError(err)
+
+	for _, format := range []string{exporter.FormatCSV, exporter.FormatJSON, exporter.FormatPDF} {
+		var want bytes.Buffer
+		require.NoError(exporter.Write(&want, format, whole))
+
+		// Тот же отчёт страницами по одному счёту.
+		var got bytes.Buffer
+		w, err := exporter.NewWriter(&got, format)
+		require.NoError(err)
+		require.NoError(w.WriteHeader(exporter.BillsHeader(period)))
+		for i := range bills {
+			page, err := exporter.BillsPage(bills[i : i+1])
+			require.NoError(err)
+			require.NoError(w.WritePage(page))
+		}
+		require.NoError(w.Close())
+
+		require.Equal(want.String(), got.String(), format)
+	}
+
+	// JSON тот же, что у json.Encoder с отступом.
+	var buf bytes.Buffer
+	require.NoError(exporter.Write(&buf, exporter.FormatJSON, exporter.LedgerReport(period, nil)))
+	require.Equal("{\n  \"user_id\": 1,\n  \"from\": \"2023-04-01T00:00:00Z\",\n  \"to\": \"2023-05-01T00:00:00Z\",\n  \"entries\": []\n}\n", buf.String())
+
+	var doc any
+	buf.Reset()
+	require.NoError(exporter.Write(&buf, exporter.FormatJSON, whole))
+	require.NoError(json.Unmarshal(buf.Bytes(), &doc))
+
+	var indented bytes.Buffer
+	encoder := json.NewEncoder(&indented)
+	encoder.SetIndent("", "  ")
+	require.NoError(encoder.Encode(doc))
+	require.JSONEq(indented.String(), buf.String())
+}
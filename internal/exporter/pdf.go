@@ -0,0 +1,273 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// PDF собирается вручную: одна таблица моноширинным Courier на листах A4
+// альбомной ориентации. Встроенные шрифты PDF не знают кириллицу, а свой
+// шрифт мы не встраиваем, поэтому русский текст транслитерируется.
+const (
+	pdfPageWidth  = 842
+	pdfPageHeight = 595
+	pdfMargin     = 36
+	pdfFontSize   = 8
+	pdfLeading    = 10
+
+	pdfMaxColumnWidth = 40
+	// Заголовок, подзаголовок, пустая строка, шапка таблицы и разделитель.
+	pdfHeaderLines = 5
+)
+
+// Ширина символа Courier - 600 единиц на 1000 размера шрифта.
+const pdfLineChars = (pdfPageWidth - 2*pdfMargin) * 10 / (pdfFontSize * 6)
+
+const pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+
+// Ширины колонок зависят от всех строк, поэтому строки копятся до Close.
+// Сам файл пишется сразу в w.
+type pdfWriter struct {
+	w      io.Writer
+	header ReportHeader
+	rows   [][]string
+}
+
+func (p *pdfWriter) WriteHeader(header ReportHeader) error {
+	p.header = header
+	return nil
+}
+
+func (p *pdfWriter) WritePage(page ReportPage) error {
+	for _, row := range page.Rows {
+		p.rows = append(p.rows, transliterateRow(row))
+	}
+
+	return nil
+}
+
+func (p *pdfWriter) Close() error {
+	columns := transliterateRow(p.header.Columns)
+	rows := p.rows
+
+	widths := pdfColumnWidths(columns, rows)
+	header := []string{
+		transliterate(p.header.Title),
+		transliterate(p.header.Subtitle),
+		"",
+		pdfTableLine(columns, widths),
+		strings.Repeat("-", len(pdfTableLine(columns, widths))),
+	}
+
+	rowsPerPage := pdfLinesPerPage - pdfHeaderLines - 1
+	pages := [][]string{}
+	for start := 0; start == 0 || start < len(rows); start += rowsPerPage {
+		end := start + rowsPerPage
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		lines := append([]string{}, header...)
+		for _, row := range rows[start:end] {
+			lines = append(lines, pdfTableLine(row, widths))
+		}
+		pages = append(pages, lines)
+	}
+
+	doc := &pdfDocument{w: p.w}
+	doc.write("%s", "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1 - каталог, 2 - дерево страниц, 3 - шрифт, дальше по два объекта
+	// на страницу: сама страница и её содержимое.
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+
+	doc.object("<< /Type /Catalog /Pages 2 0 R >>")
+	doc.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	doc.object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, lines := range pages {
+		doc.object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 5+2*i,
+		))
+
+		content := pdfPageContent(lines, fmt.Sprintf("%d / %d", i+1, len(pages)))
+		doc.object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	doc.finish()
+
+	return doc.err
+}
+
+// Пишет объекты сразу в w и запоминает их смещения для xref.
+// Первая ошибка записи сохраняется в err, дальше ничего не пишется.
+type pdfDocument struct {
+	w       io.Writer
+	written int
+	offsets []int
+	err     error
+}
+
+func (d *pdfDocument) write(format string, args ...any) {
+	if d.err != nil {
+		return
+	}
+
+	n, err := fmt.Fprintf(d.w, format, args...)
+	d.written += n
+	d.err = errors.WithStack(err)
+}
+
+func (d *pdfDocument) object(body string) {
+	d.offsets = append(d.offsets, d.written)
+	d.write("%d 0 obj\n%s\nendobj\n", len(d.offsets), body)
+}
+
+func (d *pdfDocument) finish() {
+	xref := d.written
+	d.write("xref\n0 %d\n0000000000 65535 f \n", len(d.offsets)+1)
+	for _, offset := range d.offsets {
+		d.write("%010d 00000 n \n", offset)
+	}
+	d.write("trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.offsets)+1, xref)
+}
+
+func pdfPageContent(lines []string, footer string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin)
+	for _, line := range lines {
+		fmt.Fprintf(&b, "(%s) '\n", pdfEscape(line))
+	}
+	b.WriteString("ET\n")
+
+	fmt.Fprintf(&b, "BT\n/F1 %d Tf\n%d %d Td\n(%s) Tj\nET", pdfFontSize, pdfMargin, pdfMargin/2, pdfEscape(footer))
+
+	return b.String()
+}
+
+// Ширины колонок в символах. Если таблица не помещается на лист,
+// урезаем самую широкую колонку, пока не поместится.
+func pdfColumnWidths(columns []string, rows [][]string) []int {
+	widths := make([]int, len(columns))
+	for i, column := range columns {
+		widths[i] = utf8.RuneCountInString(column)
+	}
+
+	for _, row := range rows {
+		for i := 0; i < len(row) && i < len(widths); i++ {
+			if n := utf8.RuneCountInString(row[i]); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	total := len(widths) - 1
+	for i := range widths {
+		if widths[i] > pdfMaxColumnWidth {
+			widths[i] = pdfMaxColumnWidth
+		}
+		total += widths[i]
+	}
+
+	for total > pdfLineChars {
+		widest := 0
+		for i := range widths {
+			if widths[i] > widths[widest] {
+				widest = i
+			}
+		}
+
+		if widths[widest] <= 1 {
+			break
+		}
+
+		widths[widest]--
+		total--
+	}
+
+	return widths
+}
+
+// Ячейки, дополненные пробелами до ширины колонки. Не влезающее
+// обрезается, последний символ заменяется на ~.
+func pdfTableLine(cells []string, widths []int) string {
+	parts := make([]string, 0, len(widths))
+	for i, width := range widths {
+		var cell string
+		if i < len(cells) {
+			cell = cells[i]
+		}
+
+		runes := []rune(cell)
+		if len(runes) > width {
+			runes = append(runes[:width-1], '~')
+		}
+		parts = append(parts, string(runes)+strings.Repeat(" ", width-len(runes)))
+	}
+
+	return strings.TrimRight(strings.Join(parts, " "), " ")
+}
+
+// Строка PDF в WinAnsiEncoding. Всё, что не влезает в Latin-1, - ?.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+
+	return b.String()
+}
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "ie", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+}
+
+// Транслитерация кириллицы латиницей, как в загранпаспортах.
+func transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		latin, ok := cyrillicToLatin[unicode.ToLower(r)]
+		if !ok {
+			b.WriteRune(r)
+			continue
+		}
+
+		if unicode.IsUpper(r) && latin != "" {
+			first, size := utf8.DecodeRuneInString(latin)
+			latin = string(unicode.ToUpper(first)) + latin[size:]
+		}
+		b.WriteString(latin)
+	}
+
+	return b.String()
+}
+
+func transliterateRow(row []string) []string {
+	res := make([]string, 0, len(row))
+	for _, cell := range row {
+		res = append(res, transliterate(cell))
+	}
+
+	return res
+}
@@ -0,0 +1,348 @@
+package exporter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+)
+
+const timeLayout = time.RFC3339
+
+// Чей отчёт и за какой период. Нулевые границы - без ограничений.
+type Period struct {
+	UserID models.UserID
+	From   time.Time
+	To     time.Time
+}
+
+func (p Period) Validate() error {
+	if !p.From.IsZero() && !p.To.IsZero() && !p.From.Before(p.To) {
+		return errors.Wrapf(ErrInvalidPeriod, "empty range [%s, %s)", p.From, p.To)
+	}
+
+	return nil
+}
+
+func (p Period) String() string {
+	from, to := "...", "..."
+	if !p.From.IsZero() {
+		from = p.From.Format(time.DateOnly)
+	}
+
+	if !p.To.IsZero() {
+		to = p.To.Format(time.DateOnly)
+	}
+
+	return fmt.Sprintf("user %d, [%s, %s)", p.UserID, from, to)
+}
+
+func (p Period) document() periodDocument {
+	doc := periodDocument{UserID: int64(p.UserID)}
+	if !p.From.IsZero() {
+		doc.From = p.From.Format(timeLayout)
+	}
+
+	if !p.To.IsZero() {
+		doc.To = p.To.Format(timeLayout)
+	}
+
+	return doc
+}
+
+type periodDocument struct {
+	UserID int64  `json:"user_id"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+func formatMoney(m models.Money, currency models.Currency) string {
+	return m.StringFixed(currency.Precision())
+}
+
+func formatID[T ~int64](id T) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(int64(id), 10)
+}
+
+var ledgerColumns = []string{
+	"entry_id",
+	"created_at",
+	"source",
+	"bill_id",
+	"settlement_id",
+	"group_id",
+	"counterparty_id",
+	"currency",
+	"amount",
+	"balance",
+}
+
+type ledgerEntryDocument struct {
+	EntryID        int64  `json:"entry_id"`
+	CreatedAt      string `json:"created_at"`
+	Source         string `json:"source"`
+	BillID         int64  `json:"bill_id,omitempty"`
+	SettlementID   int64  `json:"settlement_id,omitempty"`
+	GroupID        int64  `json:"group_id,omitempty"`
+	CounterpartyID int64  `json:"counterparty_id"`
+	Currency       string `json:"currency"`
+	Amount         string `json:"amount"`
+	Balance        string `json:"balance"`
+}
+
+// Проводки пользователя с нарастающим балансом, от старых к новым.
+// Положительная сумма - контрагент стал должен пользователю больше.
+func LedgerHeader(period Period) ReportHeader {
+	return ReportHeader{
+		Name:      fmt.Sprintf("ledger-%d", period.UserID),
+		Title:     "Ledger statement",
+		Subtitle:  period.String(),
+		Columns:   ledgerColumns,
+		Document:  period.document(),
+		ListField: "entries",
+	}
+}
+
+func LedgerPage(entries []models.StatementEntry) ReportPage {
+	page := ReportPage{
+		Rows:    make([][]string, 0, len(entries)),
+		Records: make([]any, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		entry := ledgerEntryDocument{
+			EntryID:        e.EntryID,
+			CreatedAt:      e.CreatedAt.Format(timeLayout),
+			Source:         string(e.Source),
+			BillID:         int64(e.BillID),
+			SettlementID:   int64(e.SettlementID),
+			GroupID:        int64(e.GroupID),
+			CounterpartyID: int64(e.CounterpartyID),
+			Currency:       e.Currency.String(),
+			Amount:         formatMoney(e.Amount, e.Currency),
+			Balance:        formatMoney(e.Balance, e.Currency),
+		}
+		page.Records = append(page.Records, entry)
+
+		page.Rows = append(page.Rows, []string{
+			strconv.FormatInt(entry.EntryID, 10),
+			entry.CreatedAt,
+			entry.Source,
+			formatID(e.BillID),
+			formatID(e.SettlementID),
+			formatID(e.GroupID),
+			formatID(e.CounterpartyID),
+			entry.Currency,
+			entry.Amount,
+			entry.Balance,
+		})
+	}
+
+	return page
+}
+
+func LedgerReport(period Period, entries []models.StatementEntry) Report {
+	return Report{
+		ReportHeader: LedgerHeader(period),
+		ReportPage:   LedgerPage(entries),
+	}
+}
+
+// Строка счёта в таблице, по колонке record:
+//   - item: позиция, quantity и amount - количество и цена позиции;
+//   - share: доля user_id в позиции title;
+//   - adjustment: надбавка или скидка;
+//   - payment: оплата user_id;
+//   - invoice: debtor_id должен user_id сумму amount.
+var billColumns = []string{
+	"bill_id",
+	"created_at",
+	"group_id",
+	"currency",
+	"record",
+	"title",
+	"user_id",
+	"debtor_id",
+	"quantity",
+	"amount",
+}
+
+type billDocument struct {
+	ID          int64                `json:"id"`
+	CreatedAt   string               `json:"created_at"`
+	OwnerID     int64                `json:"owner_id"`
+	GroupID     int64                `json:"group_id,omitempty"`
+	Revision    int                  `json:"revision"`
+	Currency    string               `json:"currency"`
+	Total       string               `json:"total"`
+	Items       []billItemDocument   `json:"items"`
+	Adjustments []adjustmentDocument `json:"adjustments,omitempty"`
+	Payments    []amountDocument     `json:"payments"`
+	Invoices    []invoiceDocument    `json:"invoices"`
+}
+
+type billItemDocument struct {
+	Title       string           `json:"title"`
+	PricePerOne string           `json:"price_per_one"`
+	Quantity    string           `json:"quantity"`
+	Total       string           `json:"total"`
+	Shares      []amountDocument `json:"shares"`
+}
+
+type adjustmentDocument struct {
+	Title  string `json:"title"`
+	Kind   string `json:"kind"`
+	Amount string `json:"amount"`
+}
+
+type amountDocument struct {
+	UserID int64  `json:"user_id"`
+	Amount string `json:"amount"`
+}
+
+// UserFrom - кому должны, UserTo - кто должен.
+type invoiceDocument struct {
+	UserFrom int64  `json:"user_from"`
+	UserTo   int64  `json:"user_to"`
+	Amount   string `json:"amount"`
+}
+
+// Счета с позициями, долями, оплатами и итоговыми долгами по Bill.ToInvoices.
+// Доли округлены до минимальной единицы валюты, поэтому их сумма может
+// отличаться от цены позиции на копейки; точные суммы - в invoice.
+func BillsHeader(period Period) ReportHeader {
+	return ReportHeader{
+		Name:      fmt.Sprintf("bills-%d", period.UserID),
+		Title:     "Bills",
+		Subtitle:  period.String(),
+		Columns:   billColumns,
+		Document:  period.document(),
+		ListField: "bills",
+	}
+}
+
+func BillsPage(bills []models.Bill) (ReportPage, error) {
+	page := ReportPage{
+		Rows:    [][]string{},
+		Records: make([]any, 0, len(bills)),
+	}
+
+	for i := range bills {
+		bill := &bills[i]
+		currency := bill.GetCurrency()
+
+		invoices, err := bill.ToInvoices()
+		if err != nil {
+			return ReportPage{}, errors.Wrapf(err, "%s", bill.ID)
+		}
+
+		b := billDocument{
+			ID:        int64(bill.ID),
+			CreatedAt: bill.CreatedAt.Format(timeLayout),
+			OwnerID:   int64(bill.OwnerID),
+			GroupID:   int64(bill.GroupID),
+			Revision:  bill.Revision,
+			Currency:  currency.String(),
+			Total:     formatMoney(bill.TotalPrice(), currency),
+			Items:     make([]billItemDocument, 0, len(bill.Items)),
+			Payments:  make([]amountDocument, 0, len(bill.Payments)),
+			Invoices:  make([]invoiceDocument, 0, len(invoices)),
+		}
+
+		row := func(record, title string, userID, debtorID models.UserID, quantity, amount string) {
+			page.Rows = append(page.Rows, []string{
+				formatID(bill.ID),
+				b.CreatedAt,
+				formatID(bill.GroupID),
+				b.Currency,
+				record,
+				title,
+				formatID(userID),
+				formatID(debtorID),
+				quantity,
+				amount,
+			})
+		}
+
+		for _, item := range bill.Items {
+			it := billItemDocument{
+				Title:       item.Title,
+				PricePerOne: formatMoney(item.PricePerOne, currency),
+				Quantity:    item.Quantity.String(),
+				Total:       formatMoney(item.TotalPrice(currency), currency),
+				Shares:      []amountDocument{},
+			}
+			row("item", it.Title, 0, 0, it.Quantity, it.Total)
+
+			prices := item.SharePricesByUser(currency)
+			for _, userID := range sortedUserIDs(prices) {
+				price := prices[userID]
+				share := amountDocument{UserID: int64(userID), Amount: formatMoney(price.Money(currency), currency)}
+				it.Shares = append(it.Shares, share)
+				row("share", it.Title, userID, 0, "", share.Amount)
+			}
+
+			b.Items = append(b.Items, it)
+		}
+
+		itemsTotal := bill.ItemsTotalPrice()
+		for _, adj := range bill.Adjustments {
+			a := adjustmentDocument{
+				Title:  adj.Title,
+				Kind:   string(adj.Kind),
+				Amount: formatMoney(adj.Value(itemsTotal, currency), currency),
+			}
+			b.Adjustments = append(b.Adjustments, a)
+			row("adjustment", a.Title, 0, 0, "", a.Amount)
+		}
+
+		for _, payment := range bill.Payments {
+			p := amountDocument{UserID: int64(payment.UserID), Amount: formatMoney(payment.Amount, currency)}
+			b.Payments = append(b.Payments, p)
+			row("payment", "", payment.UserID, 0, "", p.Amount)
+		}
+
+		for _, invoice := range invoices {
+			inv := invoiceDocument{
+				UserFrom: int64(invoice.UserFrom),
+				UserTo:   int64(invoice.UserTo),
+				Amount:   formatMoney(invoice.Value, currency),
+			}
+			b.Invoices = append(b.Invoices, inv)
+			row("invoice", "", invoice.UserFrom, invoice.UserTo, "", inv.Amount)
+		}
+
+		page.Records = append(page.Records, b)
+	}
+
+	return page, nil
+}
+
+func BillsReport(period Period, bills []models.Bill) (Report, error) {
+	page, err := BillsPage(bills)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return Report{
+		ReportHeader: BillsHeader(period),
+		ReportPage:   page,
+	}, nil
+}
+
+func sortedUserIDs[V any](m map[models.UserID]V) []models.UserID {
+	ids := make([]models.UserID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
@@ -0,0 +1,520 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnknownParticipant = errors.New("unknown participant")
+	ErrBadParticipants    = errors.New("bad participants list")
+	ErrUnknownSplit       = errors.New("unknown split type")
+	ErrSplitwisePayment   = errors.New("splitwise payment is a settlement, not a bill")
+	ErrNothingToSplit     = errors.New("nobody owes anything")
+)
+
+// Как делится сумма между participants.
+const (
+	// alice;bob - поровну. По умолчанию.
+	SplitEqual = "equal"
+	// alice:2;bob:1 - по весам.
+	SplitShares = "shares"
+	// alice:300;bob:200 - точными суммами.
+	SplitExact = "exact"
+	// alice:60;bob:40 - процентами.
+	SplitPercent = "percent"
+)
+
+// Обязательные колонки простого CSV, в любом порядке. Ещё есть
+// необязательные split и currency.
+var billColumns = []string{"date", "description", "payer", "amount", "participants"}
+
+// Обязательные колонки выгрузки Splitwise. После currency идут колонки
+// участников с их итогом по строке: плюс - ему должны, минус - должен он.
+var splitwiseColumns = []string{"date", "description", "category", "cost", "currency"}
+
+// В выгрузке Splitwise так помечены переводы между участниками.
+const splitwisePaymentCategory = "payment"
+
+// Строка без счёта, которую не надо считать ошибкой.
+var errSkipRow = errors.New("skip row")
+
+type BillCSVOptions struct {
+	// Имя участника из файла (без учёта регистра) -> пользователь. Число
+	// вместо имени - сразу ID пользователя.
+	Users map[string]models.UserID
+	// Валюта строк без колонки currency. Пустая - валюта по умолчанию.
+	Currency models.Currency
+	GroupID  models.GroupID
+}
+
+func (o BillCSVOptions) resolve(name string) (models.UserID, error) {
+	name = strings.TrimSpace(name)
+	if userID, ok := o.Users[strings.ToLower(name)]; ok {
+		return userID, nil
+	}
+
+	if id, err := strconv.ParseInt(name, 10, 64); err == nil && id > 0 {
+		return models.UserID(id), nil
+	}
+
+	return 0, errors.Wrapf(ErrUnknownParticipant, "%q", name)
+}
+
+// Разобранный файл: счета из годных строк и ошибки остальных.
+type BillsImport struct {
+	Bills  []models.ImportedBill
+	Errors []models.BillImportError
+}
+
+// Счета из CSV: простого (date,description,payer,amount,participants[,split,currency])
+// или выгрузки Splitwise, формат определяется по заголовку. Каждый счёт
+// проверяется Bill.Validate, ошибки строк собираются с номером строки.
+// Ошибка возвращается, только если файл не читается целиком.
+func ParseBillsCSV(r io.Reader, opts BillCSVOptions) (BillsImport, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return BillsImport{}, errors.Wrap(err, "line 1")
+	}
+
+	index := headerIndex(header)
+	var parse func(record []string) (models.Bill, []string, error)
+	if hasColumns(index, splitwiseColumns) {
+		parse, err = splitwiseRowParser(header, index, opts)
+	} else if hasColumns(index, billColumns) {
+		parse = billRowParser(index, opts)
+	} else {
+		err = errors.Wrapf(ErrBadHeader, "want %s or a Splitwise export", strings.Join(billColumns, ","))
+	}
+	if err != nil {
+		return BillsImport{}, errors.Wrap(err, "line 1")
+	}
+
+	var res BillsImport
+	// Одинаковые строки - разные счета (два кофе в один день), поэтому в
+	// хэш входит номер повтора.
+	seen := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			// csv.ParseError уже содержит номер строки.
+			return BillsImport{}, errors.WithStack(err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) < len(header) {
+			// Пустые строки из таблиц.
+			if isBlank(record) {
+				continue
+			}
+
+			res.Errors = append(res.Errors, models.BillImportError{
+				Line: line,
+				Err:  errors.Wrapf(ErrBadHeader, "%d fields, want %d", len(record), len(header)),
+			})
+			continue
+		}
+
+		bill, key, err := parse(record)
+		if err == errSkipRow {
+			continue
+		}
+		if err == nil {
+			bill.GroupID = opts.GroupID
+			err = bill.Validate()
+		}
+		if err != nil {
+			res.Errors = append(res.Errors, models.BillImportError{Line: line, Err: err})
+			continue
+		}
+
+		key = append(key, scopeKey(bill)...)
+		hash := rowHash(key)
+		seen[hash]++
+		if n := seen[hash]; n > 1 {
+			hash = rowHash(append(key, strconv.Itoa(n)))
+		}
+
+		res.Bills = append(res.Bills, models.ImportedBill{Line: line, SourceHash: hash, Bill: bill})
+	}
+
+	return res, nil
+}
+
+func headerIndex(header []string) map[string]int {
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\uFEFF")))
+		if _, ok := index[column]; !ok {
+			index[column] = i
+		}
+	}
+
+	return index
+}
+
+func hasColumns(index map[string]int, columns []string) bool {
+	for _, column := range columns {
+		if _, ok := index[column]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Группа и пользователи, в которых разрешились имена из строки: та же
+// строка с другим справочником имён или в другой группе - другой счёт.
+func scopeKey(bill models.Bill) []string {
+	userIDs := make([]models.UserID, 0, len(bill.Participants()))
+	for userID := range bill.Participants() {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	users := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		users = append(users, userID.String())
+	}
+
+	return []string{"group=" + bill.GroupID.String(), "users=" + strings.Join(users, ",")}
+}
+
+func rowHash(key []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(key, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+func parseBillDate(s string) (time.Time, error) {
+	date, err := time.Parse(rateDateLayout, strings.TrimSpace(s))
+	return date, errors.Wrap(err, "date")
+}
+
+func parseBillCurrency(s string, opts BillCSVOptions) (models.Currency, error) {
+	if strings.TrimSpace(s) == "" {
+		return opts.Currency, nil
+	}
+
+	currency, err := models.ParseCurrency(s)
+	return currency, errors.Wrap(err, "currency")
+}
+
+func parseDecimal(s string) (decimal.Decimal, error) {
+	return decimal.NewFromString(strings.TrimSpace(s))
+}
+
+// Строка простого CSV -> счёт из одной позиции на всю сумму.
+func billRowParser(index map[string]int, opts BillCSVOptions) func([]string) (models.Bill, []string, error) {
+	field := func(record []string, column string) string {
+		if i, ok := index[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+
+		return ""
+	}
+
+	return func(record []string) (models.Bill, []string, error) {
+		var bill models.Bill
+		var err error
+
+		key := []string{"csv"}
+		for _, column := range []string{"date", "description", "payer", "amount", "participants", "split", "currency"} {
+			key = append(key, field(record, column))
+		}
+
+		if bill.CreatedAt, err = parseBillDate(field(record, "date")); err != nil {
+			return bill, nil, err
+		}
+
+		if bill.Currency, err = parseBillCurrency(field(record, "currency"), opts); err != nil {
+			return bill, nil, err
+		}
+
+		amount, err := parseDecimal(field(record, "amount"))
+		if err != nil {
+			return bill, nil, errors.Wrap(err, "amount")
+		}
+
+		item := models.BillItem{
+			Title:       field(record, "description"),
+			PricePerOne: models.Money{Decimal: amount},
+			Quantity:    decimal.NewFromInt(1),
+		}
+		if item.Shares, err = parseShares(field(record, "participants"), field(record, "split"), opts); err != nil {
+			return bill, nil, errors.Wrap(err, "participants")
+		}
+		bill.Items = []models.BillItem{item}
+
+		if bill.Payments, err = parsePayers(field(record, "payer"), amount, opts); err != nil {
+			return bill, nil, errors.Wrap(err, "payer")
+		}
+
+		return bill, key, nil
+	}
+}
+
+// Список "имя" или "имя:число" через точку с запятой.
+type namedValue struct {
+	userID models.UserID
+	value  *decimal.Decimal
+}
+
+func parseNamedValues(s string, opts BillCSVOptions) ([]namedValue, error) {
+	var res []namedValue
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var nv namedValue
+		name, value, hasValue := strings.Cut(part, ":")
+		if hasValue {
+			d, err := parseDecimal(value)
+			if err != nil {
+				return nil, errors.Wrapf(ErrBadParticipants, "%q: %s", part, err)
+			}
+			nv.value = &d
+		}
+
+		userID, err := opts.resolve(name)
+		if err != nil {
+			return nil, err
+		}
+		nv.userID = userID
+
+		res = append(res, nv)
+	}
+
+	if len(res) == 0 {
+		return nil, errors.Wrap(ErrBadParticipants, "empty")
+	}
+
+	return res, nil
+}
+
+func parseShares(participants, split string, opts BillCSVOptions) ([]models.BillShare, error) {
+	values, err := parseNamedValues(participants, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	split = strings.ToLower(strings.TrimSpace(split))
+	if split == "" {
+		split = SplitEqual
+	}
+
+	shares := make([]models.BillShare, 0, len(values))
+	for _, nv := range values {
+		share := models.BillShare{UserID: nv.userID}
+		switch split {
+		case SplitEqual:
+			if nv.value != nil {
+				return nil, errors.Wrapf(ErrBadParticipants, "%s split takes names only", split)
+			}
+			share.Share = 1
+		case SplitShares, SplitExact, SplitPercent:
+			if nv.value == nil {
+				return nil, errors.Wrapf(ErrBadParticipants, "%s split needs name:value", split)
+			}
+
+			switch split {
+			case SplitShares:
+				if !nv.value.IsInteger() || !nv.value.IsPositive() || nv.value.GreaterThan(decimal.NewFromInt(1<<32-1)) {
+					return nil, errors.Wrapf(ErrBadParticipants, "share %s must be a positive integer", nv.value)
+				}
+				share.Share = uint32(nv.value.IntPart())
+			case SplitExact:
+				share.Mode = models.ShareModeAmount
+				share.Amount = &models.Money{Decimal: *nv.value}
+			case SplitPercent:
+				share.Mode = models.ShareModePercent
+				share.Percent = nv.value
+			}
+		default:
+			return nil, errors.Wrapf(ErrUnknownSplit, "%q", split)
+		}
+
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
+
+// Один плательщик без суммы платит всё, иначе суммы через name:value.
+func parsePayers(payer string, amount decimal.Decimal, opts BillCSVOptions) ([]models.BillPayment, error) {
+	values, err := parseNamedValues(payer, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 1 && values[0].value == nil {
+		return []models.BillPayment{{UserID: values[0].userID, Amount: models.Money{Decimal: amount}}}, nil
+	}
+
+	payments := make([]models.BillPayment, 0, len(values))
+	for _, nv := range values {
+		if nv.value == nil {
+			return nil, errors.Wrap(ErrBadParticipants, "several payers need name:amount")
+		}
+
+		payments = append(payments, models.BillPayment{UserID: nv.userID, Amount: models.Money{Decimal: *nv.value}})
+	}
+
+	return payments, nil
+}
+
+// Строка выгрузки Splitwise. В ней нет, кто сколько заплатил, только итог
+// каждого участника. Если в плюсе один, он заплатил всю стоимость, а
+// остальные должны ему свои суммы. Если в плюсе несколько, счёт
+// собирается из итогов: долги те же, но сумма счёта меньше стоимости.
+func splitwiseRowParser(header []string, index map[string]int, opts BillCSVOptions) (func([]string) (models.Bill, []string, error), error) {
+	type person struct {
+		column int
+		name   string
+		userID models.UserID
+	}
+
+	var people []person
+	for i := index["currency"] + 1; i < len(header); i++ {
+		name := strings.TrimSpace(header[i])
+		if name == "" {
+			continue
+		}
+
+		userID, err := opts.resolve(name)
+		if err != nil {
+			return nil, err
+		}
+
+		people = append(people, person{column: i, name: name, userID: userID})
+	}
+
+	// В хэш участники входят по имени в одном порядке, чтобы новые
+	// колонки в следующей выгрузке не меняли хэш старых строк.
+	sort.Slice(people, func(i, j int) bool { return people[i].name < people[j].name })
+
+	return func(record []string) (models.Bill, []string, error) {
+		var bill models.Bill
+		var err error
+
+		field := func(column string) string { return strings.TrimSpace(record[index[column]]) }
+
+		// Последняя строка выгрузки - итоги участников без даты.
+		if field("date") == "" && field("category") == "" {
+			return bill, nil, errSkipRow
+		}
+
+		if strings.EqualFold(field("category"), splitwisePaymentCategory) {
+			return bill, nil, ErrSplitwisePayment
+		}
+
+		if bill.CreatedAt, err = parseBillDate(field("date")); err != nil {
+			return bill, nil, err
+		}
+
+		if bill.Currency, err = parseBillCurrency(field("currency"), opts); err != nil {
+			return bill, nil, err
+		}
+
+		cost, err := parseDecimal(field("cost"))
+		if err != nil {
+			return bill, nil, errors.Wrap(err, "cost")
+		}
+
+		key := []string{"splitwise", field("date"), field("description"), cost.String(), bill.Currency.String()}
+		nets := map[models.UserID]decimal.Decimal{}
+		var userIDs []models.UserID
+		for _, p := range people {
+			value := strings.TrimSpace(record[p.column])
+			if value == "" {
+				continue
+			}
+
+			net, err := parseDecimal(value)
+			if err != nil {
+				return bill, nil, errors.Wrap(err, p.name)
+			}
+			if net.IsZero() {
+				continue
+			}
+
+			key = append(key, fmt.Sprintf("%s=%s", p.name, net))
+			if _, ok := nets[p.userID]; !ok {
+				userIDs = append(userIDs, p.userID)
+			}
+			nets[p.userID] = nets[p.userID].Add(net)
+		}
+
+		var creditors []models.UserID
+		for _, userID := range userIDs {
+			if nets[userID].IsPositive() {
+				creditors = append(creditors, userID)
+			}
+		}
+
+		switch len(creditors) {
+		case 0:
+			return bill, nil, ErrNothingToSplit
+		case 1:
+			bill.Payments = []models.BillPayment{{UserID: creditors[0], Amount: models.Money{Decimal: cost}}}
+		default:
+			for _, userID := range creditors {
+				bill.Payments = append(bill.Payments, models.BillPayment{UserID: userID, Amount: models.Money{Decimal: nets[userID]}})
+			}
+		}
+
+		item := models.BillItem{
+			Title:       field("description"),
+			PricePerOne: bill.TotalPayment(),
+			Quantity:    decimal.NewFromInt(1),
+		}
+		for _, userID := range userIDs {
+			// Доля - сколько участник потратил: оплата минус итог.
+			owed := nets[userID].Neg()
+			for _, payment := range bill.Payments {
+				if payment.UserID == userID {
+					owed = owed.Add(payment.Amount.Decimal)
+				}
+			}
+			if !owed.IsPositive() {
+				continue
+			}
+
+			amount := models.Money{Decimal: owed}
+			item.Shares = append(item.Shares, models.BillShare{UserID: userID, Mode: models.ShareModeAmount, Amount: &amount})
+		}
+		bill.Items = []models.BillItem{item}
+
+		return bill, key, nil
+	}, nil
+}
@@ -0,0 +1,143 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/importer"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+var billUsers = importer.BillCSVOptions{
+	Users: map[string]models.UserID{"alice": 1, "bob": 2, "carol": 3},
+}
+
+func TestParseBillsCSV(t *testing.T) {
+	require := require.New(t)
+
+	parsed, err := importer.ParseBillsCSV(strings.NewReader(
+		"date,description,payer,amount,participants,split,currency\n"+
+			"2023-03-01,Пицца,alice,900,alice;Bob;carol,,\n"+
+			"2023-03-02,Такси,bob,300,alice:2;bob:1,shares,USD\n"+
+			"2023-03-03,Продукты,alice:500;carol:500,1000,alice:600;bob:400,exact,\n"+
+			"2023-03-04,Кино,carol,1000,alice:60;4:40,percent,\n"+
+			"2023-03-01,Пицца,alice,900,alice;Bob;carol,,\n",
+	), billUsers)
+	require.NoError(err)
+	require.Empty(parsed.Errors)
+	require.Len(parsed.Bills, 5)
+
+	pizza := parsed.Bills[0]
+	require.Equal(2, pizza.Line)
+	require.Equal(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), pizza.Bill.CreatedAt)
+	require.Equal("Пицца", pizza.Bill.Items[0].Title)
+	require.Len(pizza.Bill.Items[0].Shares, 3)
+	require.Equal(models.UserID(2), pizza.Bill.Items[0].Shares[1].UserID)
+	require.Equal([]models.BillPayment{{UserID: 1, Amount: models.Money{Decimal: decimal.NewFromInt(900)}}}, pizza.Bill.Payments)
+
+	require.Equal(models.Currency("USD"), parsed.Bills[1].Bill.Currency)
+	require.Equal(uint32(2), parsed.Bills[1].Bill.Items[0].Shares[0].Share)
+	require.Len(parsed.Bills[2].Bill.Payments, 2)
+	require.Equal(models.ShareModeAmount, parsed.Bills[2].Bill.Items[0].Shares[0].Mode)
+	require.Equal(models.UserID(4), parsed.Bills[3].Bill.Items[0].Shares[1].UserID)
+
+	// Повтор строки - другой счёт, но хэш стабилен между запусками.
+	require.NotEqual(pizza.SourceHash, parsed.Bills[4].SourceHash)
+	again, err := importer.ParseBillsCSV(strings.NewReader(
+		"currency,split,participants,amount,payer,description,date\n"+
+			",,alice;Bob;carol,900,alice,Пицца,2023-03-01\n",
+	), billUsers)
+	require.NoError(err)
+	require.Equal(pizza.SourceHash, again.Bills[0].SourceHash)
+
+	// Та же строка в другой группе или с другими пользователями за именами -
+	// другой счёт.
+	inGroup := billUsers
+	inGroup.GroupID = 7
+	other := importer.BillCSVOptions{Users: map[string]models.UserID{"alice": 1, "bob": 5, "carol": 3}}
+	for _, opts := range []importer.BillCSVOptions{inGroup, other} {
+		scoped, err := importer.ParseBillsCSV(strings.NewReader(
+			"date,description,payer,amount,participants\n"+
+				"2023-03-01,Пицца,alice,900,alice;Bob;carol\n",
+		), opts)
+		require.NoError(err)
+		require.NotEqual(pizza.SourceHash, scoped.Bills[0].SourceHash)
+	}
+}
+
+func TestParseBillsCSVErrors(t *testing.T) {
+	require := require.New(t)
+
+	parsed, err := importer.ParseBillsCSV(strings.NewReader(
+		"date,description,payer,amount,participants,split\n"+
+			"2023-03-01,Пицца,alice,900,alice;bob,\n"+
+			"2023-03-01,Пицца,dave,900,alice;bob,\n"+
+			"2023-03-01,Пицца,alice,900,alice:500;bob:300,exact\n"+
+			"01.03.2023,Пицца,alice,900,alice;bob,\n"+
+			"2023-03-01,Пицца,alice,900,alice;bob,weird\n"+
+			"2023-03-01,Пицца,bob:100,900,alice;bob,\n"+
+			"\n"+
+			"2023-03-01,Пицца\n",
+	), billUsers)
+	require.NoError(err)
+	require.Len(parsed.Bills, 1)
+	require.Len(parsed.Errors, 6)
+
+	lines := make([]int, 0, len(parsed.Errors))
+	for _, e := range parsed.Errors {
+		lines = append(lines, e.Line)
+	}
+	require.Equal([]int{3, 4, 5, 6, 7, 9}, lines)
+
+	require.ErrorIs(parsed.Errors[0], importer.ErrUnknownParticipant)
+	require.ErrorIs(parsed.Errors[1], models.ErrShareUnderAllocated)
+	require.ErrorContains(parsed.Errors[2], "date")
+	require.ErrorIs(parsed.Errors[3], importer.ErrUnknownSplit)
+	require.ErrorIs(parsed.Errors[4], models.ErrDiscrepancy)
+	require.ErrorContains(parsed.Errors[0], "line 3")
+
+	_, err = importer.ParseBillsCSV(strings.NewReader("date,title,amount\n"), billUsers)
+	require.ErrorIs(err, importer.ErrBadHeader)
+}
+
+func TestParseBillsSplitwise(t *testing.T) {
+	require := require.New(t)
+
+	parsed, err := importer.ParseBillsCSV(strings.NewReader(
+		"\uFEFFDate,Description,Category,Cost,Currency,Alice,Bob,Carol\n"+
+			"\n"+
+			"2023-03-01,Ужин,Dining out,90.00,RUB,60.00,-30.00,-30.00\n"+
+			"2023-03-02,Бензин,Gas/fuel,100.00,RUB,20.00,30.00,-50.00\n"+
+			"2023-03-03,Alice paid Bob,Payment,30.00,RUB,30.00,-30.00,0.00\n"+
+			"2023-03-04,Кофе,General,5.00,RUB,0.00,0.00,0.00\n"+
+			"\n"+
+			", Total balance , , , RUB,80.00,-30.00,-80.00\n",
+	), billUsers)
+	require.NoError(err)
+	require.Len(parsed.Bills, 2)
+
+	dinner := parsed.Bills[0].Bill
+	require.Equal(3, parsed.Bills[0].Line)
+	require.Equal("90", dinner.TotalPrice().String())
+	require.Equal([]models.BillPayment{{UserID: 1, Amount: models.Money{Decimal: decimal.RequireFromString("90.00")}}}, dinner.Payments)
+	invoices, err := dinner.ToInvoices()
+	require.NoError(err)
+	require.Len(invoices, 2)
+
+	// Два плательщика: стоимость теряется, долги сохраняются.
+	fuel := parsed.Bills[1].Bill
+	require.Len(fuel.Payments, 2)
+	require.Equal("50", fuel.TotalPrice().String())
+	require.Equal("-50", fuel.BalanceByUser()[3].FloatString(0))
+
+	require.Len(parsed.Errors, 2)
+	require.ErrorIs(parsed.Errors[0], importer.ErrSplitwisePayment)
+	require.Equal(5, parsed.Errors[0].Line)
+	require.ErrorIs(parsed.Errors[1], importer.ErrNothingToSplit)
+
+	_, err = importer.ParseBillsCSV(strings.NewReader("Date,Description,Category,Cost,Currency,Dave\n"), billUsers)
+	require.ErrorIs(err, importer.ErrUnknownParticipant)
+}
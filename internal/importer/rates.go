@@ -0,0 +1,163 @@
+// Разбор файлов для загрузки данных извне.
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const rateDateLayout = time.DateOnly
+
+var (
+	ErrUnknownFormat = errors.New("unknown file format")
+	ErrBadHeader     = errors.New("unexpected header")
+	ErrDuplicateRate = errors.New("duplicate exchange rate")
+)
+
+// Колонки CSV с курсами, в этом порядке.
+var rateColumns = []string{"date", "base", "quote", "rate"}
+
+// Курс в JSON: {"date": "2023-03-01", "base": "USD", "quote": "RUB", "rate": "75.43"}.
+type jsonRate struct {
+	Date  string          `json:"date"`
+	Base  string          `json:"base"`
+	Quote string          `json:"quote"`
+	Rate  decimal.Decimal `json:"rate"`
+}
+
+// Курсы из файла в формате format (csv или json). Ошибки с номером строки
+// (для JSON - с номером элемента массива).
+func ParseRates(r io.Reader, format string) ([]models.ExchangeRate, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return ParseRatesCSV(r)
+	case "json":
+		return ParseRatesJSON(r)
+	}
+
+	return nil, errors.Wrapf(ErrUnknownFormat, "%q", format)
+}
+
+// CSV с заголовком date,base,quote,rate.
+func ParseRatesCSV(r io.Reader) ([]models.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(rateColumns)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "line 1")
+	}
+
+	for i, column := range rateColumns {
+		if strings.ToLower(strings.TrimSpace(header[i])) != column {
+			return nil, errors.Wrapf(ErrBadHeader, "line 1: want %s", strings.Join(rateColumns, ","))
+		}
+	}
+
+	var rates []models.ExchangeRate
+	seen := rateLines{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			// csv.ParseError уже содержит номер строки.
+			return nil, errors.WithStack(err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rate, err := rateFromStrings(record[0], record[1], record[2], record[3])
+		if err == nil {
+			err = seen.add(rate, fmt.Sprintf("line %d", line))
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// JSON-массив объектов jsonRate.
+func ParseRatesJSON(r io.Reader) ([]models.ExchangeRate, error) {
+	var items []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rates := make([]models.ExchangeRate, 0, len(items))
+	seen := rateLines{}
+	for i, raw := range items {
+		var item jsonRate
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, errors.Wrapf(err, "item %d", i)
+		}
+
+		rate, err := rateFromStrings(item.Date, item.Base, item.Quote, item.Rate.String())
+		if err == nil {
+			err = seen.add(rate, fmt.Sprintf("item %d", i))
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "item %d", i)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+type rateKey struct {
+	base, quote models.Currency
+	date        time.Time
+}
+
+// Где в файле уже встретился курс пары на дату. Повтор - ошибка: какой из
+// двух курсов верный, решает автор файла.
+type rateLines map[rateKey]string
+
+func (l rateLines) add(rate models.ExchangeRate, at string) error {
+	key := rateKey{base: rate.Base, quote: rate.Quote, date: models.RateDate(rate.Date)}
+	if first, ok := l[key]; ok {
+		return errors.Wrapf(ErrDuplicateRate, "%s/%s on %s, first at %s", rate.Base, rate.Quote, key.date.Format(rateDateLayout), first)
+	}
+	l[key] = at
+
+	return nil
+}
+
+func rateFromStrings(date, base, quote, value string) (models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	var err error
+
+	if rate.Date, err = time.Parse(rateDateLayout, strings.TrimSpace(date)); err != nil {
+		return rate, errors.Wrap(err, "date")
+	}
+
+	if rate.Base, err = models.ParseCurrency(base); err != nil {
+		return rate, errors.Wrap(err, "base")
+	}
+
+	if rate.Quote, err = models.ParseCurrency(quote); err != nil {
+		return rate, errors.Wrap(err, "quote")
+	}
+
+	if rate.Rate, err = decimal.NewFromString(strings.TrimSpace(value)); err != nil {
+		return rate, errors.Wrap(err, "rate")
+	}
+
+	return rate, rate.Validate()
+}
@@ -0,0 +1,73 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/importer"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRatesCSV(t *testing.T) {
+	require := require.New(t)
+
+	rates, err := importer.ParseRates(strings.NewReader(
+		"date,base,quote,rate\n"+
+			"2023-03-01,USD,RUB,75.4323\n"+
+			"2023-03-01, eur, rub, 80.1\n",
+	), "csv")
+	require.NoError(err)
+	require.Len(rates, 2)
+	require.Equal(models.Currency("EUR"), rates[1].Base)
+	require.True(rates[0].Rate.Equal(decimal.RequireFromString("75.4323")))
+
+	_, err = importer.ParseRatesCSV(strings.NewReader(
+		"date,base,quote,rate\n" +
+			"2023-03-01,USD,RUB,75\n" +
+			"2023-03-01,USD,RUB,-1\n",
+	))
+	require.ErrorIs(err, models.ErrInvalidExchangeRate)
+	require.ErrorContains(err, "line 3")
+
+	_, err = importer.ParseRatesCSV(strings.NewReader(
+		"date,base,quote,rate\n" +
+			"2023-03-01,USD,RUB,75\n" +
+			"2023-03-01,EUR,RUB,80\n" +
+			"2023-03-01,usd,rub,76\n",
+	))
+	require.ErrorIs(err, importer.ErrDuplicateRate)
+	require.ErrorContains(err, "line 4")
+	require.ErrorContains(err, "first at line 2")
+
+	_, err = importer.ParseRatesCSV(strings.NewReader("base,quote,date,rate\n"))
+	require.ErrorIs(err, importer.ErrBadHeader)
+}
+
+func TestParseRatesJSON(t *testing.T) {
+	require := require.New(t)
+
+	rates, err := importer.ParseRates(strings.NewReader(
+		`[{"date": "2023-03-01", "base": "KWD", "quote": "USD", "rate": "3.25"}]`,
+	), "json")
+	require.NoError(err)
+	require.Len(rates, 1)
+	require.Equal(models.Currency("KWD"), rates[0].Base)
+
+	_, err = importer.ParseRatesJSON(strings.NewReader(
+		`[{"date": "2023-03-01", "base": "KWD", "quote": "XXX", "rate": "3.25"}]`,
+	))
+	require.ErrorIs(err, models.ErrUnknownCurrency)
+	require.ErrorContains(err, "item 0")
+
+	_, err = importer.ParseRatesJSON(strings.NewReader(
+		`[{"date": "2023-03-01", "base": "KWD", "quote": "USD", "rate": "3.25"},` +
+			` {"date": "2023-03-01", "base": "KWD", "quote": "USD", "rate": "3.26"}]`,
+	))
+	require.ErrorIs(err, importer.ErrDuplicateRate)
+	require.ErrorContains(err, "item 1")
+
+	_, err = importer.ParseRates(strings.NewReader(""), "xml")
+	require.ErrorIs(err, importer.ErrUnknownFormat)
+}
@@ -0,0 +1,313 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrBadReceiptQR    = errors.New("bad fiscal receipt QR code")
+	ErrBadReceipt      = errors.New("bad fiscal receipt")
+	ErrReceiptMismatch = errors.New("receipt does not match QR code")
+	ErrReceiptRefund   = errors.New("refund receipt is not a bill")
+)
+
+// Чеки ФНС всегда в рублях.
+const receiptCurrency = models.Currency("RUB")
+
+// Время в QR-коде: с секундами и без.
+var receiptQRTimeLayouts = []string{"20060102T150405", "20060102T1504"}
+
+// Признак расчёта (n в QR-коде, operationType в чеке).
+const (
+	ReceiptIncome        = 1
+	ReceiptIncomeReturn  = 2
+	ReceiptExpense       = 3
+	ReceiptExpenseReturn = 4
+)
+
+// Реквизиты чека из QR-кода: t=20230301T1230&s=1234.50&fn=...&i=...&fp=...&n=1.
+// Время - местное время кассы, без часового пояса.
+type ReceiptQR struct {
+	Time time.Time
+	Sum  models.Money
+	// Номер фискального накопителя.
+	FN string
+	// Номер фискального документа.
+	FD string
+	// Фискальный признак документа.
+	FP        string
+	Operation int
+}
+
+func ParseReceiptQR(s string) (ReceiptQR, error) {
+	var qr ReceiptQR
+
+	values, err := url.ParseQuery(strings.TrimSpace(s))
+	if err != nil {
+		return qr, errors.Wrap(ErrBadReceiptQR, err.Error())
+	}
+
+	get := func(key string) (string, error) {
+		v := strings.TrimSpace(values.Get(key))
+		if v == "" {
+			return "", errors.Wrapf(ErrBadReceiptQR, "no %s", key)
+		}
+
+		return v, nil
+	}
+
+	t, err := get("t")
+	if err != nil {
+		return qr, err
+	}
+	for _, layout := range receiptQRTimeLayouts {
+		if qr.Time, err = time.Parse(layout, t); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return qr, errors.Wrapf(ErrBadReceiptQR, "t=%s", t)
+	}
+
+	sum, err := get("s")
+	if err != nil {
+		return qr, err
+	}
+	amount, err := decimal.NewFromString(sum)
+	if err != nil || !amount.IsPositive() {
+		return qr, errors.Wrapf(ErrBadReceiptQR, "s=%s", sum)
+	}
+	qr.Sum = models.Money{Decimal: amount}
+	if err := qr.Sum.Validate(receiptCurrency); err != nil {
+		return qr, errors.Wrapf(ErrBadReceiptQR, "s=%s: %s", sum, err)
+	}
+
+	for _, field := range []struct {
+		key string
+		dst *string
+	}{{"fn", &qr.FN}, {"i", &qr.FD}, {"fp", &qr.FP}} {
+		if *field.dst, err = get(field.key); err != nil {
+			return qr, err
+		}
+		if !isDigits(*field.dst) {
+			return qr, errors.Wrapf(ErrBadReceiptQR, "%s=%s", field.key, *field.dst)
+		}
+	}
+
+	n, err := get("n")
+	if err != nil {
+		return qr, err
+	}
+	if qr.Operation, err = strconv.Atoi(n); err != nil || qr.Operation < ReceiptIncome || qr.Operation > ReceiptExpenseReturn {
+		return qr, errors.Wrapf(ErrBadReceiptQR, "n=%s", n)
+	}
+
+	return qr, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// Чек в формате ФНС. Суммы в копейках.
+type FNSReceipt struct {
+	DateTime             fnsDateTime      `json:"dateTime"`
+	TotalSum             int64            `json:"totalSum"`
+	FiscalDriveNumber    json.Number      `json:"fiscalDriveNumber"`
+	FiscalDocumentNumber json.Number      `json:"fiscalDocumentNumber"`
+	FiscalSign           json.Number      `json:"fiscalSign"`
+	OperationType        int              `json:"operationType"`
+	User                 string           `json:"user"`
+	RetailPlace          string           `json:"retailPlace"`
+	Items                []FNSReceiptItem `json:"items"`
+}
+
+type FNSReceiptItem struct {
+	Name     string      `json:"name"`
+	Price    int64       `json:"price"`
+	Quantity json.Number `json:"quantity"`
+	Sum      int64       `json:"sum"`
+}
+
+// Время чека: строкой "2023-03-01T12:30:00" или числом секунд Unix.
+// Как и в QR-коде, это местное время кассы.
+type fnsDateTime struct{ time.Time }
+
+func (t *fnsDateTime) UnmarshalJSON(data []byte) error {
+	if unix, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		t.Time = time.Unix(unix, 0).UTC()
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return errors.Wrapf(ErrBadReceipt, "dateTime %q", s)
+}
+
+// Чек ФНС из JSON. Чек может лежать сам по себе, в document.receipt
+// (ответ ФНС) или в ticket.document.receipt первого элемента массива
+// (выгрузка приложения "Проверка чеков").
+func ParseFNSReceipt(r io.Reader) (FNSReceipt, error) {
+	var receipt FNSReceipt
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return receipt, errors.WithStack(err)
+	}
+
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return receipt, errors.Wrap(ErrBadReceipt, err.Error())
+		}
+		if len(list) != 1 {
+			return receipt, errors.Wrapf(ErrBadReceipt, "%d receipts in file, want 1", len(list))
+		}
+		data = list[0]
+	}
+
+	var envelope struct {
+		Ticket *struct {
+			Document struct {
+				Receipt json.RawMessage `json:"receipt"`
+			} `json:"document"`
+		} `json:"ticket"`
+		Document *struct {
+			Receipt json.RawMessage `json:"receipt"`
+		} `json:"document"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return receipt, errors.Wrap(ErrBadReceipt, err.Error())
+	}
+
+	switch {
+	case envelope.Ticket != nil && envelope.Ticket.Document.Receipt != nil:
+		data = envelope.Ticket.Document.Receipt
+	case envelope.Document != nil && envelope.Document.Receipt != nil:
+		data = envelope.Document.Receipt
+	}
+
+	if err := json.Unmarshal(data, &receipt); err != nil {
+		return receipt, errors.Wrap(ErrBadReceipt, err.Error())
+	}
+
+	if len(receipt.Items) == 0 {
+		return receipt, errors.Wrap(ErrBadReceipt, "no items")
+	}
+
+	return receipt, nil
+}
+
+// Тот ли это чек, что в QR-коде: накопитель, документ, признак, сумма и
+// признак расчёта.
+func (r *FNSReceipt) Matches(qr ReceiptQR) error {
+	mismatch := func(field string, got, want any) error {
+		return errors.Wrapf(ErrReceiptMismatch, "%s: receipt %v, QR %v", field, got, want)
+	}
+
+	switch {
+	case r.FiscalDriveNumber.String() != qr.FN:
+		return mismatch("fn", r.FiscalDriveNumber, qr.FN)
+	case r.FiscalDocumentNumber.String() != qr.FD:
+		return mismatch("i", r.FiscalDocumentNumber, qr.FD)
+	case r.FiscalSign.String() != qr.FP:
+		return mismatch("fp", r.FiscalSign, qr.FP)
+	case !kopecks(r.TotalSum).Equal(qr.Sum.Decimal):
+		return mismatch("s", kopecks(r.TotalSum), qr.Sum)
+	case r.OperationType != qr.Operation:
+		return mismatch("n", r.OperationType, qr.Operation)
+	}
+
+	return nil
+}
+
+func kopecks(v int64) decimal.Decimal {
+	return decimal.New(v, -receiptCurrency.Precision())
+}
+
+// Черновик счёта: позиции чека без долей и оплат, их добавит пользователь.
+// Если цена на количество не сходится с суммой позиции в чеке (скидка,
+// округление кассы), позиция берётся одной штукой на сумму из чека.
+// Чек возврата - не новый счёт, а отмена старого, поэтому он не принимается.
+func (r *FNSReceipt) Bill() (models.Bill, error) {
+	if r.OperationType == ReceiptIncomeReturn || r.OperationType == ReceiptExpenseReturn {
+		return models.Bill{}, errors.Wrapf(ErrReceiptRefund, "operation type %d", r.OperationType)
+	}
+
+	bill := models.Bill{
+		CreatedAt: r.DateTime.Time,
+		Currency:  receiptCurrency,
+		Items:     make([]models.BillItem, 0, len(r.Items)),
+	}
+
+	var total int64
+	for index, item := range r.Items {
+		if item.Sum < 0 || item.Price < 0 {
+			return models.Bill{}, errors.Wrapf(ErrBadReceipt, "item at index %d: negative price", index)
+		}
+
+		quantity, err := decimal.NewFromString(item.Quantity.String())
+		if err != nil || !quantity.IsPositive() {
+			return models.Bill{}, errors.Wrapf(ErrBadReceipt, "item at index %d: quantity %q", index, item.Quantity)
+		}
+
+		billItem := models.BillItem{
+			Title:       strings.TrimSpace(item.Name),
+			PricePerOne: models.Money{Decimal: kopecks(item.Price)},
+			Quantity:    quantity,
+		}
+
+		precision := -quantity.Exponent()
+		if precision > models.MaxQuantityPrecision || !billItem.TotalPrice(receiptCurrency).Equal(kopecks(item.Sum)) {
+			billItem.PricePerOne = models.Money{Decimal: kopecks(item.Sum)}
+			billItem.Quantity = decimal.NewFromInt(1)
+		} else if precision > bill.GetQuantityPrecision() {
+			bill.QuantityPrecision = precision
+		}
+
+		bill.Items = append(bill.Items, billItem)
+		total += item.Sum
+	}
+
+	if r.TotalSum != 0 && total != r.TotalSum {
+		return models.Bill{}, errors.Wrapf(ErrBadReceipt, "items sum %s, total %s", kopecks(total), kopecks(r.TotalSum))
+	}
+
+	return bill, nil
+}
+
+// Черновик счёта по QR-коду и чеку, полученному по нему от ФНС.
+func ReceiptBill(qr ReceiptQR, receipt FNSReceipt) (models.Bill, error) {
+	if err := receipt.Matches(qr); err != nil {
+		return models.Bill{}, err
+	}
+
+	return receipt.Bill()
+}
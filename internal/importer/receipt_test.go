@@ -0,0 +1,128 @@
+package importer_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/SlamJam/dolgovnya-backend/internal/app/models"
+	"github.com/SlamJam/dolgovnya-backend/internal/importer"
+	"github.com/stretchr/testify/require"
+)
+
+func parseReceiptFixture(t *testing.T, name string) importer.FNSReceipt {
+	f, err := os.Open("testdata/" + name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	receipt, err := importer.ParseFNSReceipt(f)
+	require.NoError(t, err)
+
+	return receipt
+}
+
+func TestParseReceiptQR(t *testing.T) {
+	require := require.New(t)
+
+	qr, err := importer.ParseReceiptQR("t=20230301T1230&s=1234.50&fn=9289000100123456&i=51234&fp=3127712345&n=1")
+	require.NoError(err)
+	require.Equal(time.Date(2023, 3, 1, 12, 30, 0, 0, time.UTC), qr.Time)
+	require.Equal("1234.5", qr.Sum.String())
+	require.Equal("9289000100123456", qr.FN)
+	require.Equal("51234", qr.FD)
+	require.Equal("3127712345", qr.FP)
+	require.Equal(importer.ReceiptIncome, qr.Operation)
+
+	qr, err = importer.ParseReceiptQR("fn=1&i=2&fp=3&n=2&s=10&t=20230301T123045")
+	require.NoError(err)
+	require.Equal(45, qr.Time.Second())
+
+	for _, bad := range []string{
+		"",
+		"t=20230301T1230&s=1234.50&fn=9289000100123456&i=51234&fp=3127712345",
+		"t=2023-03-01&s=1234.50&fn=9289000100123456&i=51234&fp=3127712345&n=1",
+		"t=20230301T1230&s=12.345&fn=9289000100123456&i=51234&fp=3127712345&n=1",
+		"t=20230301T1230&s=-1&fn=9289000100123456&i=51234&fp=3127712345&n=1",
+		"t=20230301T1230&s=1234.50&fn=92890001A&i=51234&fp=3127712345&n=1",
+		"t=20230301T1230&s=1234.50&fn=9289000100123456&i=51234&fp=3127712345&n=5",
+	} {
+		_, err := importer.ParseReceiptQR(bad)
+		require.ErrorIs(err, importer.ErrBadReceiptQR, bad)
+	}
+}
+
+func TestFNSReceiptBill(t *testing.T) {
+	require := require.New(t)
+
+	receipt := parseReceiptFixture(t, "receipt_ticket.json")
+	require.Equal("Магазин у дома", receipt.RetailPlace)
+
+	qr, err := importer.ParseReceiptQR("t=20230301T1230&s=1234.50&fn=9289000100123456&i=51234&fp=3127712345&n=1")
+	require.NoError(err)
+
+	bill, err := importer.ReceiptBill(qr, receipt)
+	require.NoError(err)
+	require.Equal(models.Currency("RUB"), bill.Currency)
+	require.Equal(qr.Time, bill.CreatedAt)
+	require.Len(bill.Items, 4)
+	require.Equal("1234.5", bill.ItemsTotalPrice().String())
+
+	milk := bill.Items[0]
+	require.Equal("Молоко 3,2% 1л", milk.Title)
+	require.Equal("89.9", milk.PricePerOne.String())
+	require.Equal("2", milk.Quantity.String())
+
+	cheese := bill.Items[1]
+	require.Equal("0.356", cheese.Quantity.String())
+	require.Equal("248.84", cheese.TotalPrice(bill.GetCurrency()).String())
+
+	// Скидка: цена на количество не сходится с суммой, берётся сумма.
+	coffee := bill.Items[3]
+	require.Equal("750.96", coffee.PricePerOne.String())
+	require.Equal("1", coffee.Quantity.String())
+
+	// Черновик без долей и оплат: их добавит пользователь.
+	require.ErrorIs(bill.Validate(), models.ErrNoShares)
+	for i := range bill.Items {
+		bill.Items[i].Shares = []models.BillShare{{UserID: 1, Share: 1}}
+	}
+	bill.Payments = []models.BillPayment{{UserID: 1, Amount: qr.Sum}}
+	require.NoError(bill.Validate())
+
+	other, err := importer.ParseReceiptQR("t=20230301T1230&s=1234.50&fn=9289000100123456&i=51235&fp=3127712345&n=1")
+	require.NoError(err)
+	_, err = importer.ReceiptBill(other, receipt)
+	require.ErrorIs(err, importer.ErrReceiptMismatch)
+
+	// Тот же чек, но в QR-коде возврат прихода.
+	refundQR, err := importer.ParseReceiptQR("t=20230301T1230&s=1234.50&fn=9289000100123456&i=51234&fp=3127712345&n=2")
+	require.NoError(err)
+	_, err = importer.ReceiptBill(refundQR, receipt)
+	require.ErrorIs(err, importer.ErrReceiptMismatch)
+
+	// Чек возврата сходится с QR-кодом, но счётом не становится.
+	for _, operation := range []int{importer.ReceiptIncomeReturn, importer.ReceiptExpenseReturn} {
+		refund := receipt
+		refund.OperationType = operation
+		refundQR.Operation = operation
+		_, err = importer.ReceiptBill(refundQR, refund)
+		require.ErrorIs(err, importer.ErrReceiptRefund)
+	}
+}
+
+func TestFNSReceiptDocument(t *testing.T) {
+	require := require.New(t)
+
+	receipt := parseReceiptFixture(t, "receipt_document.json")
+	require.Equal(time.Date(2023, 3, 2, 12, 30, 0, 0, time.UTC), receipt.DateTime.Time)
+
+	bill, err := receipt.Bill()
+	require.NoError(err)
+	require.Len(bill.Items, 1)
+	require.Equal("Капучино 0,3", bill.Items[0].Title)
+	require.Equal("300", bill.TotalPrice().String())
+
+	receipt.TotalSum = 29900
+	_, err = receipt.Bill()
+	require.ErrorIs(err, importer.ErrBadReceipt)
+}
@@ -0,0 +1,1187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: dolgovnya/balance/v1/balance.proto
+
+package balancev1
+
+import (
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NetKind int32
+
+const (
+	NetKind_NET_KIND_UNSPECIFIED NetKind = 0
+	NetKind_NET_KIND_ZERO        NetKind = 1
+	// Пользователю должны больше, чем он сам.
+	NetKind_NET_KIND_SURPLUS NetKind = 2
+	// Пользователь должен больше, чем должны ему.
+	NetKind_NET_KIND_SCARCITY NetKind = 3
+)
+
+// Enum value maps for NetKind.
+var (
+	NetKind_name = map[int32]string{
+		0: "NET_KIND_UNSPECIFIED",
+		1: "NET_KIND_ZERO",
+		2: "NET_KIND_SURPLUS",
+		3: "NET_KIND_SCARCITY",
+	}
+	NetKind_value = map[string]int32{
+		"NET_KIND_UNSPECIFIED": 0,
+		"NET_KIND_ZERO":        1,
+		"NET_KIND_SURPLUS":     2,
+		"NET_KIND_SCARCITY":    3,
+	}
+)
+
+func (x NetKind) Enum() *NetKind {
+	p := new(NetKind)
+	*p = x
+	return p
+}
+
+func (x NetKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_balance_v1_balance_proto_enumTypes[0].Descriptor()
+}
+
+func (NetKind) Type() protoreflect.EnumType {
+	return &file_dolgovnya_balance_v1_balance_proto_enumTypes[0]
+}
+
+func (x NetKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetKind.Descriptor instead.
+func (NetKind) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{0}
+}
+
+type StatementSource int32
+
+const (
+	StatementSource_STATEMENT_SOURCE_UNSPECIFIED StatementSource = 0
+	StatementSource_STATEMENT_SOURCE_BILL        StatementSource = 1
+	StatementSource_STATEMENT_SOURCE_SETTLEMENT  StatementSource = 2
+)
+
+// Enum value maps for StatementSource.
+var (
+	StatementSource_name = map[int32]string{
+		0: "STATEMENT_SOURCE_UNSPECIFIED",
+		1: "STATEMENT_SOURCE_BILL",
+		2: "STATEMENT_SOURCE_SETTLEMENT",
+	}
+	StatementSource_value = map[string]int32{
+		"STATEMENT_SOURCE_UNSPECIFIED": 0,
+		"STATEMENT_SOURCE_BILL":        1,
+		"STATEMENT_SOURCE_SETTLEMENT":  2,
+	}
+)
+
+func (x StatementSource) Enum() *StatementSource {
+	p := new(StatementSource)
+	*p = x
+	return p
+}
+
+func (x StatementSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_balance_v1_balance_proto_enumTypes[1].Descriptor()
+}
+
+func (StatementSource) Type() protoreflect.EnumType {
+	return &file_dolgovnya_balance_v1_balance_proto_enumTypes[1]
+}
+
+func (x StatementSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementSource.Descriptor instead.
+func (StatementSource) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{1}
+}
+
+// Итог по всем проводкам пользователя в одной валюте.
+type CurrencyBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Сколько пользователь должен другим.
+	Debit *money.Money `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit,omitempty"`
+	// Сколько другие должны пользователю.
+	Credit *money.Money `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit,omitempty"`
+	// Модуль разницы credit - debit, знак в net_kind.
+	Net     *money.Money `protobuf:"bytes,3,opt,name=net,proto3" json:"net,omitempty"`
+	NetKind NetKind      `protobuf:"varint,4,opt,name=net_kind,json=netKind,proto3,enum=dolgovnya.balance.v1.NetKind" json:"net_kind,omitempty"`
+}
+
+func (x *CurrencyBalance) Reset() {
+	*x = CurrencyBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyBalance) ProtoMessage() {}
+
+func (x *CurrencyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyBalance.ProtoReflect.Descriptor instead.
+func (*CurrencyBalance) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CurrencyBalance) GetDebit() *money.Money {
+	if x != nil {
+		return x.Debit
+	}
+	return nil
+}
+
+func (x *CurrencyBalance) GetCredit() *money.Money {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+func (x *CurrencyBalance) GetNet() *money.Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *CurrencyBalance) GetNetKind() NetKind {
+	if x != nil {
+		return x.NetKind
+	}
+	return NetKind_NET_KIND_UNSPECIFIED
+}
+
+type GetMyBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMyBalanceRequest) Reset() {
+	*x = GetMyBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBalanceRequest) ProtoMessage() {}
+
+func (x *GetMyBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetMyBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{1}
+}
+
+type GetMyBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// По одному на валюту, в которой были проводки, по коду валюты.
+	Balances []*CurrencyBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *GetMyBalanceResponse) Reset() {
+	*x = GetMyBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBalanceResponse) ProtoMessage() {}
+
+func (x *GetMyBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetMyBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMyBalanceResponse) GetBalances() []*CurrencyBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// Баланс с одним контрагентом. Положительный - контрагент должен пользователю.
+type CounterpartyBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64        `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CounterpartyBalance) Reset() {
+	*x = CounterpartyBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterpartyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterpartyBalance) ProtoMessage() {}
+
+func (x *CounterpartyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterpartyBalance.ProtoReflect.Descriptor instead.
+func (*CounterpartyBalance) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CounterpartyBalance) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CounterpartyBalance) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Курс, по которому пересчитаны балансы: 1 base = rate quote.
+type ExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base  string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Rate  *decimal.Decimal       `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Date  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExchangeRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() *decimal.Decimal {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *ExchangeRate) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetCounterpartyBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Код валюты ISO 4217. Если задан, то балансы во всех валютах пересчитываются
+	// в неё и складываются.
+	ConvertTo string `protobuf:"bytes,1,opt,name=convert_to,json=convertTo,proto3" json:"convert_to,omitempty"`
+	// Дата курсов для convert_to. Не задана - сегодня.
+	RatesDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=rates_date,json=ratesDate,proto3" json:"rates_date,omitempty"`
+}
+
+func (x *GetCounterpartyBalancesRequest) Reset() {
+	*x = GetCounterpartyBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCounterpartyBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterpartyBalancesRequest) ProtoMessage() {}
+
+func (x *GetCounterpartyBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterpartyBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetCounterpartyBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCounterpartyBalancesRequest) GetConvertTo() string {
+	if x != nil {
+		return x.ConvertTo
+	}
+	return ""
+}
+
+func (x *GetCounterpartyBalancesRequest) GetRatesDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RatesDate
+	}
+	return nil
+}
+
+type GetCounterpartyBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ненулевые балансы, по валюте и user_id.
+	Balances []*CounterpartyBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	// Курсы, которые пошли в расчёт. Только с convert_to.
+	Rates []*ExchangeRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	// Точная сумма пересчитанных балансов минус сумма округлённых, в валюте
+	// convert_to, до 12 знаков после запятой. Только с convert_to.
+	Residue *decimal.Decimal `protobuf:"bytes,3,opt,name=residue,proto3" json:"residue,omitempty"`
+}
+
+func (x *GetCounterpartyBalancesResponse) Reset() {
+	*x = GetCounterpartyBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCounterpartyBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterpartyBalancesResponse) ProtoMessage() {}
+
+func (x *GetCounterpartyBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterpartyBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetCounterpartyBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCounterpartyBalancesResponse) GetBalances() []*CounterpartyBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetCounterpartyBalancesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *GetCounterpartyBalancesResponse) GetResidue() *decimal.Decimal {
+	if x != nil {
+		return x.Residue
+	}
+	return nil
+}
+
+// Одна проводка глазами пользователя.
+type StatementEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryId   int64                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Source    StatementSource        `protobuf:"varint,3,opt,name=source,proto3,enum=dolgovnya.balance.v1.StatementSource" json:"source,omitempty"`
+	// Заполнен один из двух, по source.
+	BillId         uint64 `protobuf:"varint,4,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+	SettlementId   uint64 `protobuf:"varint,5,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	GroupId        int64  `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CounterpartyId int64  `protobuf:"varint,7,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// Положительная - контрагент стал должен пользователю больше.
+	Amount *money.Money `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Баланс в валюте amount после этой проводки, с учётом всех более ранних
+	// проводок под те же counterparty_id и group_id.
+	Balance *money.Money `protobuf:"bytes,9,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *StatementEntry) Reset() {
+	*x = StatementEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatementEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementEntry) ProtoMessage() {}
+
+func (x *StatementEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementEntry.ProtoReflect.Descriptor instead.
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StatementEntry) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StatementEntry) GetSource() StatementSource {
+	if x != nil {
+		return x.Source
+	}
+	return StatementSource_STATEMENT_SOURCE_UNSPECIFIED
+}
+
+func (x *StatementEntry) GetBillId() uint64 {
+	if x != nil {
+		return x.BillId
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetSettlementId() uint64 {
+	if x != nil {
+		return x.SettlementId
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetCounterpartyId() int64 {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *StatementEntry) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type GetStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Если 0, то размер страницы по умолчанию.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token из предыдущего ответа.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Только проводки с этим контрагентом.
+	CounterpartyId int64 `protobuf:"varint,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// Только проводки по счетам и погашениям группы.
+	GroupId int64 `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Полуинтервал [from, to). Проводки до from входят в баланс первой строки.
+	From *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetStatementRequest) Reset() {
+	*x = GetStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementRequest) ProtoMessage() {}
+
+func (x *GetStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStatementRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetStatementRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetStatementRequest) GetCounterpartyId() int64 {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return 0
+}
+
+func (x *GetStatementRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GetStatementRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetStatementRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Проводки от старых к новым.
+type GetStatementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StatementEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetStatementResponse) Reset() {
+	*x = GetStatementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementResponse) ProtoMessage() {}
+
+func (x *GetStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_balance_v1_balance_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetStatementResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_balance_v1_balance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetStatementResponse) GetEntries() []*StatementEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetStatementResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_dolgovnya_balance_v1_balance_proto protoreflect.FileDescriptor
+
+var file_dolgovnya_balance_v1_balance_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xc7, 0x01, 0x0a, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x64, 0x65, 0x62, 0x69, 0x74, 0x12, 0x2a, 0x0a,
+	0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x6e, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x6e, 0x65, 0x74, 0x12,
+	0x38, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x13, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x7a, 0x0a, 0x1e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x12, 0x39, 0x0a,
+	0x0a, 0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75, 0x65, 0x22, 0x81, 0x03,
+	0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e,
+	0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0xf1, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x63, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x14, 0x4e, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x45,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x4e, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x52, 0x50, 0x4c, 0x55,
+	0x53, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x53, 0x43, 0x41, 0x52, 0x43, 0x49, 0x54, 0x59, 0x10, 0x03, 0x2a, 0x6f, 0x0a, 0x0f, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x1c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x42, 0x49, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
+	0x45, 0x54, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x32, 0xe7, 0x02, 0x0a, 0x0e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x6f, 0x6c, 0x67,
+	0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x34, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
+	0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x6f, 0x6c, 0x67,
+	0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xeb, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x6f,
+	0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53,
+	0x6c, 0x61, 0x6d, 0x4a, 0x61, 0x6d, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x42, 0x58, 0xaa, 0x02, 0x14, 0x44, 0x6f, 0x6c, 0x67,
+	0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x14, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
+	0x6e, 0x79, 0x61, 0x5c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x44, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x3a, 0x3a, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dolgovnya_balance_v1_balance_proto_rawDescOnce sync.Once
+	file_dolgovnya_balance_v1_balance_proto_rawDescData = file_dolgovnya_balance_v1_balance_proto_rawDesc
+)
+
+func file_dolgovnya_balance_v1_balance_proto_rawDescGZIP() []byte {
+	file_dolgovnya_balance_v1_balance_proto_rawDescOnce.Do(func() {
+		file_dolgovnya_balance_v1_balance_proto_rawDescData = protoimpl.X.CompressGZIP(file_dolgovnya_balance_v1_balance_proto_rawDescData)
+	})
+	return file_dolgovnya_balance_v1_balance_proto_rawDescData
+}
+
+var file_dolgovnya_balance_v1_balance_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_dolgovnya_balance_v1_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_dolgovnya_balance_v1_balance_proto_goTypes = []interface{}{
+	(NetKind)(0),                            // 0: dolgovnya.balance.v1.NetKind
+	(StatementSource)(0),                    // 1: dolgovnya.balance.v1.StatementSource
+	(*CurrencyBalance)(nil),                 // 2: dolgovnya.balance.v1.CurrencyBalance
+	(*GetMyBalanceRequest)(nil),             // 3: dolgovnya.balance.v1.GetMyBalanceRequest
+	(*GetMyBalanceResponse)(nil),            // 4: dolgovnya.balance.v1.GetMyBalanceResponse
+	(*CounterpartyBalance)(nil),             // 5: dolgovnya.balance.v1.CounterpartyBalance
+	(*ExchangeRate)(nil),                    // 6: dolgovnya.balance.v1.ExchangeRate
+	(*GetCounterpartyBalancesRequest)(nil),  // 7: dolgovnya.balance.v1.GetCounterpartyBalancesRequest
+	(*GetCounterpartyBalancesResponse)(nil), // 8: dolgovnya.balance.v1.GetCounterpartyBalancesResponse
+	(*StatementEntry)(nil),                  // 9: dolgovnya.balance.v1.StatementEntry
+	(*GetStatementRequest)(nil),             // 10: dolgovnya.balance.v1.GetStatementRequest
+	(*GetStatementResponse)(nil),            // 11: dolgovnya.balance.v1.GetStatementResponse
+	(*money.Money)(nil),                     // 12: google.type.Money
+	(*decimal.Decimal)(nil),                 // 13: google.type.Decimal
+	(*timestamppb.Timestamp)(nil),           // 14: google.protobuf.Timestamp
+}
+var file_dolgovnya_balance_v1_balance_proto_depIdxs = []int32{
+	12, // 0: dolgovnya.balance.v1.CurrencyBalance.debit:type_name -> google.type.Money
+	12, // 1: dolgovnya.balance.v1.CurrencyBalance.credit:type_name -> google.type.Money
+	12, // 2: dolgovnya.balance.v1.CurrencyBalance.net:type_name -> google.type.Money
+	0,  // 3: dolgovnya.balance.v1.CurrencyBalance.net_kind:type_name -> dolgovnya.balance.v1.NetKind
+	2,  // 4: dolgovnya.balance.v1.GetMyBalanceResponse.balances:type_name -> dolgovnya.balance.v1.CurrencyBalance
+	12, // 5: dolgovnya.balance.v1.CounterpartyBalance.amount:type_name -> google.type.Money
+	13, // 6: dolgovnya.balance.v1.ExchangeRate.rate:type_name -> google.type.Decimal
+	14, // 7: dolgovnya.balance.v1.ExchangeRate.date:type_name -> google.protobuf.Timestamp
+	14, // 8: dolgovnya.balance.v1.GetCounterpartyBalancesRequest.rates_date:type_name -> google.protobuf.Timestamp
+	5,  // 9: dolgovnya.balance.v1.GetCounterpartyBalancesResponse.balances:type_name -> dolgovnya.balance.v1.CounterpartyBalance
+	6,  // 10: dolgovnya.balance.v1.GetCounterpartyBalancesResponse.rates:type_name -> dolgovnya.balance.v1.ExchangeRate
+	13, // 11: dolgovnya.balance.v1.GetCounterpartyBalancesResponse.residue:type_name -> google.type.Decimal
+	14, // 12: dolgovnya.balance.v1.StatementEntry.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 13: dolgovnya.balance.v1.StatementEntry.source:type_name -> dolgovnya.balance.v1.StatementSource
+	12, // 14: dolgovnya.balance.v1.StatementEntry.amount:type_name -> google.type.Money
+	12, // 15: dolgovnya.balance.v1.StatementEntry.balance:type_name -> google.type.Money
+	14, // 16: dolgovnya.balance.v1.GetStatementRequest.from:type_name -> google.protobuf.Timestamp
+	14, // 17: dolgovnya.balance.v1.GetStatementRequest.to:type_name -> google.protobuf.Timestamp
+	9,  // 18: dolgovnya.balance.v1.GetStatementResponse.entries:type_name -> dolgovnya.balance.v1.StatementEntry
+	3,  // 19: dolgovnya.balance.v1.BalanceService.GetMyBalance:input_type -> dolgovnya.balance.v1.GetMyBalanceRequest
+	7,  // 20: dolgovnya.balance.v1.BalanceService.GetCounterpartyBalances:input_type -> dolgovnya.balance.v1.GetCounterpartyBalancesRequest
+	10, // 21: dolgovnya.balance.v1.BalanceService.GetStatement:input_type -> dolgovnya.balance.v1.GetStatementRequest
+	4,  // 22: dolgovnya.balance.v1.BalanceService.GetMyBalance:output_type -> dolgovnya.balance.v1.GetMyBalanceResponse
+	8,  // 23: dolgovnya.balance.v1.BalanceService.GetCounterpartyBalances:output_type -> dolgovnya.balance.v1.GetCounterpartyBalancesResponse
+	11, // 24: dolgovnya.balance.v1.BalanceService.GetStatement:output_type -> dolgovnya.balance.v1.GetStatementResponse
+	22, // [22:25] is the sub-list for method output_type
+	19, // [19:22] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_dolgovnya_balance_v1_balance_proto_init() }
+func file_dolgovnya_balance_v1_balance_proto_init() {
+	if File_dolgovnya_balance_v1_balance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterpartyBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCounterpartyBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCounterpartyBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_balance_v1_balance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dolgovnya_balance_v1_balance_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dolgovnya_balance_v1_balance_proto_goTypes,
+		DependencyIndexes: file_dolgovnya_balance_v1_balance_proto_depIdxs,
+		EnumInfos:         file_dolgovnya_balance_v1_balance_proto_enumTypes,
+		MessageInfos:      file_dolgovnya_balance_v1_balance_proto_msgTypes,
+	}.Build()
+	File_dolgovnya_balance_v1_balance_proto = out.File
+	file_dolgovnya_balance_v1_balance_proto_rawDesc = nil
+	file_dolgovnya_balance_v1_balance_proto_goTypes = nil
+	file_dolgovnya_balance_v1_balance_proto_depIdxs = nil
+}
@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: dolgovnya/balance/v1/balance.proto
+
+package balancev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BalanceService_GetMyBalance_FullMethodName            = "/dolgovnya.balance.v1.BalanceService/GetMyBalance"
+	BalanceService_GetCounterpartyBalances_FullMethodName = "/dolgovnya.balance.v1.BalanceService/GetCounterpartyBalances"
+	BalanceService_GetStatement_FullMethodName            = "/dolgovnya.balance.v1.BalanceService/GetStatement"
+)
+
+// BalanceServiceClient is the client API for BalanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BalanceServiceClient interface {
+	GetMyBalance(ctx context.Context, in *GetMyBalanceRequest, opts ...grpc.CallOption) (*GetMyBalanceResponse, error)
+	GetCounterpartyBalances(ctx context.Context, in *GetCounterpartyBalancesRequest, opts ...grpc.CallOption) (*GetCounterpartyBalancesResponse, error)
+	// Выписка по проводкам с нарастающим балансом.
+	GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error)
+}
+
+type balanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBalanceServiceClient(cc grpc.ClientConnInterface) BalanceServiceClient {
+	return &balanceServiceClient{cc}
+}
+
+func (c *balanceServiceClient) GetMyBalance(ctx context.Context, in *GetMyBalanceRequest, opts ...grpc.CallOption) (*GetMyBalanceResponse, error) {
+	out := new(GetMyBalanceResponse)
+	err := c.cc.Invoke(ctx, BalanceService_GetMyBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *balanceServiceClient) GetCounterpartyBalances(ctx context.Context, in *GetCounterpartyBalancesRequest, opts ...grpc.CallOption) (*GetCounterpartyBalancesResponse, error) {
+	out := new(GetCounterpartyBalancesResponse)
+	err := c.cc.Invoke(ctx, BalanceService_GetCounterpartyBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *balanceServiceClient) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error) {
+	out := new(GetStatementResponse)
+	err := c.cc.Invoke(ctx, BalanceService_GetStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BalanceServiceServer is the server API for BalanceService service.
+// All implementations must embed UnimplementedBalanceServiceServer
+// for forward compatibility
+type BalanceServiceServer interface {
+	GetMyBalance(context.Context, *GetMyBalanceRequest) (*GetMyBalanceResponse, error)
+	GetCounterpartyBalances(context.Context, *GetCounterpartyBalancesRequest) (*GetCounterpartyBalancesResponse, error)
+	// Выписка по проводкам с нарастающим балансом.
+	GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error)
+	mustEmbedUnimplementedBalanceServiceServer()
+}
+
+// UnimplementedBalanceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBalanceServiceServer struct {
+}
+
+func (UnimplementedBalanceServiceServer) GetMyBalance(context.Context, *GetMyBalanceRequest) (*GetMyBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyBalance not implemented")
+}
+func (UnimplementedBalanceServiceServer) GetCounterpartyBalances(context.Context, *GetCounterpartyBalancesRequest) (*GetCounterpartyBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounterpartyBalances not implemented")
+}
+func (UnimplementedBalanceServiceServer) GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedBalanceServiceServer) mustEmbedUnimplementedBalanceServiceServer() {}
+
+// UnsafeBalanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BalanceServiceServer will
+// result in compilation errors.
+type UnsafeBalanceServiceServer interface {
+	mustEmbedUnimplementedBalanceServiceServer()
+}
+
+func RegisterBalanceServiceServer(s grpc.ServiceRegistrar, srv BalanceServiceServer) {
+	s.RegisterService(&BalanceService_ServiceDesc, srv)
+}
+
+func _BalanceService_GetMyBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceServiceServer).GetMyBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BalanceService_GetMyBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceServiceServer).GetMyBalance(ctx, req.(*GetMyBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BalanceService_GetCounterpartyBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCounterpartyBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceServiceServer).GetCounterpartyBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BalanceService_GetCounterpartyBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceServiceServer).GetCounterpartyBalances(ctx, req.(*GetCounterpartyBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BalanceService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BalanceServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BalanceService_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BalanceServiceServer).GetStatement(ctx, req.(*GetStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BalanceService_ServiceDesc is the grpc.ServiceDesc for BalanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BalanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dolgovnya.balance.v1.BalanceService",
+	HandlerType: (*BalanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyBalance",
+			Handler:    _BalanceService_GetMyBalance_Handler,
+		},
+		{
+			MethodName: "GetCounterpartyBalances",
+			Handler:    _BalanceService_GetCounterpartyBalances_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _BalanceService_GetStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dolgovnya/balance/v1/balance.proto",
+}
@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dolgovnya/balance/v1/balance.proto
+
+package balancev1connect
+
+import (
+	context "context"
+	errors "errors"
+	v1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/balance/v1"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// BalanceServiceName is the fully-qualified name of the BalanceService service.
+	BalanceServiceName = "dolgovnya.balance.v1.BalanceService"
+)
+
+// BalanceServiceClient is a client for the dolgovnya.balance.v1.BalanceService service.
+type BalanceServiceClient interface {
+	GetMyBalance(context.Context, *connect_go.Request[v1.GetMyBalanceRequest]) (*connect_go.Response[v1.GetMyBalanceResponse], error)
+	GetCounterpartyBalances(context.Context, *connect_go.Request[v1.GetCounterpartyBalancesRequest]) (*connect_go.Response[v1.GetCounterpartyBalancesResponse], error)
+	// Выписка по проводкам с нарастающим балансом.
+	GetStatement(context.Context, *connect_go.Request[v1.GetStatementRequest]) (*connect_go.Response[v1.GetStatementResponse], error)
+}
+
+// NewBalanceServiceClient constructs a client for the dolgovnya.balance.v1.BalanceService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBalanceServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) BalanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &balanceServiceClient{
+		getMyBalance: connect_go.NewClient[v1.GetMyBalanceRequest, v1.GetMyBalanceResponse](
+			httpClient,
+			baseURL+"/dolgovnya.balance.v1.BalanceService/GetMyBalance",
+			opts...,
+		),
+		getCounterpartyBalances: connect_go.NewClient[v1.GetCounterpartyBalancesRequest, v1.GetCounterpartyBalancesResponse](
+			httpClient,
+			baseURL+"/dolgovnya.balance.v1.BalanceService/GetCounterpartyBalances",
+			opts...,
+		),
+		getStatement: connect_go.NewClient[v1.GetStatementRequest, v1.GetStatementResponse](
+			httpClient,
+			baseURL+"/dolgovnya.balance.v1.BalanceService/GetStatement",
+			opts...,
+		),
+	}
+}
+
+// balanceServiceClient implements BalanceServiceClient.
+type balanceServiceClient struct {
+	getMyBalance            *connect_go.Client[v1.GetMyBalanceRequest, v1.GetMyBalanceResponse]
+	getCounterpartyBalances *connect_go.Client[v1.GetCounterpartyBalancesRequest, v1.GetCounterpartyBalancesResponse]
+	getStatement            *connect_go.Client[v1.GetStatementRequest, v1.GetStatementResponse]
+}
+
+// GetMyBalance calls dolgovnya.balance.v1.BalanceService.GetMyBalance.
+func (c *balanceServiceClient) GetMyBalance(ctx context.Context, req *connect_go.Request[v1.GetMyBalanceRequest]) (*connect_go.Response[v1.GetMyBalanceResponse], error) {
+	return c.getMyBalance.CallUnary(ctx, req)
+}
+
+// GetCounterpartyBalances calls dolgovnya.balance.v1.BalanceService.GetCounterpartyBalances.
+func (c *balanceServiceClient) GetCounterpartyBalances(ctx context.Context, req *connect_go.Request[v1.GetCounterpartyBalancesRequest]) (*connect_go.Response[v1.GetCounterpartyBalancesResponse], error) {
+	return c.getCounterpartyBalances.CallUnary(ctx, req)
+}
+
+// GetStatement calls dolgovnya.balance.v1.BalanceService.GetStatement.
+func (c *balanceServiceClient) GetStatement(ctx context.Context, req *connect_go.Request[v1.GetStatementRequest]) (*connect_go.Response[v1.GetStatementResponse], error) {
+	return c.getStatement.CallUnary(ctx, req)
+}
+
+// BalanceServiceHandler is an implementation of the dolgovnya.balance.v1.BalanceService service.
+type BalanceServiceHandler interface {
+	GetMyBalance(context.Context, *connect_go.Request[v1.GetMyBalanceRequest]) (*connect_go.Response[v1.GetMyBalanceResponse], error)
+	GetCounterpartyBalances(context.Context, *connect_go.Request[v1.GetCounterpartyBalancesRequest]) (*connect_go.Response[v1.GetCounterpartyBalancesResponse], error)
+	// Выписка по проводкам с нарастающим балансом.
+	GetStatement(context.Context, *connect_go.Request[v1.GetStatementRequest]) (*connect_go.Response[v1.GetStatementResponse], error)
+}
+
+// NewBalanceServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBalanceServiceHandler(svc BalanceServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/dolgovnya.balance.v1.BalanceService/GetMyBalance", connect_go.NewUnaryHandler(
+		"/dolgovnya.balance.v1.BalanceService/GetMyBalance",
+		svc.GetMyBalance,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.balance.v1.BalanceService/GetCounterpartyBalances", connect_go.NewUnaryHandler(
+		"/dolgovnya.balance.v1.BalanceService/GetCounterpartyBalances",
+		svc.GetCounterpartyBalances,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.balance.v1.BalanceService/GetStatement", connect_go.NewUnaryHandler(
+		"/dolgovnya.balance.v1.BalanceService/GetStatement",
+		svc.GetStatement,
+		opts...,
+	))
+	return "/dolgovnya.balance.v1.BalanceService/", mux
+}
+
+// UnimplementedBalanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBalanceServiceHandler struct{}
+
+func (UnimplementedBalanceServiceHandler) GetMyBalance(context.Context, *connect_go.Request[v1.GetMyBalanceRequest]) (*connect_go.Response[v1.GetMyBalanceResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.balance.v1.BalanceService.GetMyBalance is not implemented"))
+}
+
+func (UnimplementedBalanceServiceHandler) GetCounterpartyBalances(context.Context, *connect_go.Request[v1.GetCounterpartyBalancesRequest]) (*connect_go.Response[v1.GetCounterpartyBalancesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.balance.v1.BalanceService.GetCounterpartyBalances is not implemented"))
+}
+
+func (UnimplementedBalanceServiceHandler) GetStatement(context.Context, *connect_go.Request[v1.GetStatementRequest]) (*connect_go.Response[v1.GetStatementResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.balance.v1.BalanceService.GetStatement is not implemented"))
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: dolgovnya/export/v1/export.proto
+
+package exportv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Report int32
+
+const (
+	Report_REPORT_UNSPECIFIED Report = 0
+	// Проводки с нарастающим балансом.
+	Report_REPORT_LEDGER Report = 1
+	// Счета с позициями, долями, оплатами и долгами.
+	Report_REPORT_BILLS Report = 2
+)
+
+// Enum value maps for Report.
+var (
+	Report_name = map[int32]string{
+		0: "REPORT_UNSPECIFIED",
+		1: "REPORT_LEDGER",
+		2: "REPORT_BILLS",
+	}
+	Report_value = map[string]int32{
+		"REPORT_UNSPECIFIED": 0,
+		"REPORT_LEDGER":      1,
+		"REPORT_BILLS":       2,
+	}
+)
+
+func (x Report) Enum() *Report {
+	p := new(Report)
+	*p = x
+	return p
+}
+
+func (x Report) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Report) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_export_v1_export_proto_enumTypes[0].Descriptor()
+}
+
+func (Report) Type() protoreflect.EnumType {
+	return &file_dolgovnya_export_v1_export_proto_enumTypes[0]
+}
+
+func (x Report) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Report.Descriptor instead.
+func (Report) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_export_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0
+	Format_FORMAT_CSV         Format = 1
+	Format_FORMAT_JSON        Format = 2
+	Format_FORMAT_PDF         Format = 3
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_CSV",
+		2: "FORMAT_JSON",
+		3: "FORMAT_PDF",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_CSV":         1,
+		"FORMAT_JSON":        2,
+		"FORMAT_PDF":         3,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_export_v1_export_proto_enumTypes[1].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_dolgovnya_export_v1_export_proto_enumTypes[1]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_export_v1_export_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report Report `protobuf:"varint,1,opt,name=report,proto3,enum=dolgovnya.export.v1.Report" json:"report,omitempty"`
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=dolgovnya.export.v1.Format" json:"format,omitempty"`
+	// Период [from, to), любая граница может отсутствовать.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_export_v1_export_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_export_v1_export_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_export_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportRequest) GetReport() Report {
+	if x != nil {
+		return x.Report
+	}
+	return Report_REPORT_UNSPECIFIED
+}
+
+func (x *ExportRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Файл приходит кусками, склеивать data по порядку.
+type ExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Только в первом сообщении.
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Только в первом сообщении.
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportResponse) Reset() {
+	*x = ExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_export_v1_export_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResponse) ProtoMessage() {}
+
+func (x *ExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_export_v1_export_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResponse.ProtoReflect.Descriptor instead.
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_export_v1_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_dolgovnya_export_v1_export_proto protoreflect.FileDescriptor
+
+var file_dolgovnya_export_v1_export_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x64, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x45, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x49, 0x4c, 0x4c, 0x53, 0x10, 0x02, 0x2a, 0x51, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x03,
+	0x32, 0x64, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x53, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x64, 0x6f,
+	0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0xe3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x64,
+	0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x42, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x6c,
+	0x61, 0x6d, 0x4a, 0x61, 0x6d, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2d,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x44, 0x45, 0x58, 0xaa, 0x02, 0x13, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e,
+	0x79, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x44,
+	0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x3a, 0x3a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dolgovnya_export_v1_export_proto_rawDescOnce sync.Once
+	file_dolgovnya_export_v1_export_proto_rawDescData = file_dolgovnya_export_v1_export_proto_rawDesc
+)
+
+func file_dolgovnya_export_v1_export_proto_rawDescGZIP() []byte {
+	file_dolgovnya_export_v1_export_proto_rawDescOnce.Do(func() {
+		file_dolgovnya_export_v1_export_proto_rawDescData = protoimpl.X.CompressGZIP(file_dolgovnya_export_v1_export_proto_rawDescData)
+	})
+	return file_dolgovnya_export_v1_export_proto_rawDescData
+}
+
+var file_dolgovnya_export_v1_export_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_dolgovnya_export_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dolgovnya_export_v1_export_proto_goTypes = []interface{}{
+	(Report)(0),                   // 0: dolgovnya.export.v1.Report
+	(Format)(0),                   // 1: dolgovnya.export.v1.Format
+	(*ExportRequest)(nil),         // 2: dolgovnya.export.v1.ExportRequest
+	(*ExportResponse)(nil),        // 3: dolgovnya.export.v1.ExportResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_dolgovnya_export_v1_export_proto_depIdxs = []int32{
+	0, // 0: dolgovnya.export.v1.ExportRequest.report:type_name -> dolgovnya.export.v1.Report
+	1, // 1: dolgovnya.export.v1.ExportRequest.format:type_name -> dolgovnya.export.v1.Format
+	4, // 2: dolgovnya.export.v1.ExportRequest.from:type_name -> google.protobuf.Timestamp
+	4, // 3: dolgovnya.export.v1.ExportRequest.to:type_name -> google.protobuf.Timestamp
+	2, // 4: dolgovnya.export.v1.ExportService.Export:input_type -> dolgovnya.export.v1.ExportRequest
+	3, // 5: dolgovnya.export.v1.ExportService.Export:output_type -> dolgovnya.export.v1.ExportResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_dolgovnya_export_v1_export_proto_init() }
+func file_dolgovnya_export_v1_export_proto_init() {
+	if File_dolgovnya_export_v1_export_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dolgovnya_export_v1_export_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_export_v1_export_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dolgovnya_export_v1_export_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dolgovnya_export_v1_export_proto_goTypes,
+		DependencyIndexes: file_dolgovnya_export_v1_export_proto_depIdxs,
+		EnumInfos:         file_dolgovnya_export_v1_export_proto_enumTypes,
+		MessageInfos:      file_dolgovnya_export_v1_export_proto_msgTypes,
+	}.Build()
+	File_dolgovnya_export_v1_export_proto = out.File
+	file_dolgovnya_export_v1_export_proto_rawDesc = nil
+	file_dolgovnya_export_v1_export_proto_goTypes = nil
+	file_dolgovnya_export_v1_export_proto_depIdxs = nil
+}
@@ -0,0 +1,88 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dolgovnya/export/v1/export.proto
+
+package exportv1connect
+
+import (
+	context "context"
+	errors "errors"
+	v1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/export/v1"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// ExportServiceName is the fully-qualified name of the ExportService service.
+	ExportServiceName = "dolgovnya.export.v1.ExportService"
+)
+
+// ExportServiceClient is a client for the dolgovnya.export.v1.ExportService service.
+type ExportServiceClient interface {
+	// Отчёт по текущему пользователю.
+	Export(context.Context, *connect_go.Request[v1.ExportRequest]) (*connect_go.ServerStreamForClient[v1.ExportResponse], error)
+}
+
+// NewExportServiceClient constructs a client for the dolgovnya.export.v1.ExportService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewExportServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ExportServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &exportServiceClient{
+		export: connect_go.NewClient[v1.ExportRequest, v1.ExportResponse](
+			httpClient,
+			baseURL+"/dolgovnya.export.v1.ExportService/Export",
+			opts...,
+		),
+	}
+}
+
+// exportServiceClient implements ExportServiceClient.
+type exportServiceClient struct {
+	export *connect_go.Client[v1.ExportRequest, v1.ExportResponse]
+}
+
+// Export calls dolgovnya.export.v1.ExportService.Export.
+func (c *exportServiceClient) Export(ctx context.Context, req *connect_go.Request[v1.ExportRequest]) (*connect_go.ServerStreamForClient[v1.ExportResponse], error) {
+	return c.export.CallServerStream(ctx, req)
+}
+
+// ExportServiceHandler is an implementation of the dolgovnya.export.v1.ExportService service.
+type ExportServiceHandler interface {
+	// Отчёт по текущему пользователю.
+	Export(context.Context, *connect_go.Request[v1.ExportRequest], *connect_go.ServerStream[v1.ExportResponse]) error
+}
+
+// NewExportServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewExportServiceHandler(svc ExportServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/dolgovnya.export.v1.ExportService/Export", connect_go.NewServerStreamHandler(
+		"/dolgovnya.export.v1.ExportService/Export",
+		svc.Export,
+		opts...,
+	))
+	return "/dolgovnya.export.v1.ExportService/", mux
+}
+
+// UnimplementedExportServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedExportServiceHandler struct{}
+
+func (UnimplementedExportServiceHandler) Export(context.Context, *connect_go.Request[v1.ExportRequest], *connect_go.ServerStream[v1.ExportResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.export.v1.ExportService.Export is not implemented"))
+}
@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dolgovnya/group/v1/group.proto
+
+package groupv1connect
+
+import (
+	context "context"
+	errors "errors"
+	v1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/group/v1"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// GroupServiceName is the fully-qualified name of the GroupService service.
+	GroupServiceName = "dolgovnya.group.v1.GroupService"
+)
+
+// GroupServiceClient is a client for the dolgovnya.group.v1.GroupService service.
+type GroupServiceClient interface {
+	CreateGroup(context.Context, *connect_go.Request[v1.CreateGroupRequest]) (*connect_go.Response[v1.CreateGroupResponse], error)
+	GetGroup(context.Context, *connect_go.Request[v1.GetGroupRequest]) (*connect_go.Response[v1.GetGroupResponse], error)
+	ListGroups(context.Context, *connect_go.Request[v1.ListGroupsRequest]) (*connect_go.Response[v1.ListGroupsResponse], error)
+	AddGroupMember(context.Context, *connect_go.Request[v1.AddGroupMemberRequest]) (*connect_go.Response[v1.AddGroupMemberResponse], error)
+	UpdateGroupMember(context.Context, *connect_go.Request[v1.UpdateGroupMemberRequest]) (*connect_go.Response[v1.UpdateGroupMemberResponse], error)
+	RemoveGroupMember(context.Context, *connect_go.Request[v1.RemoveGroupMemberRequest]) (*connect_go.Response[v1.RemoveGroupMemberResponse], error)
+	GetGroupBalances(context.Context, *connect_go.Request[v1.GetGroupBalancesRequest]) (*connect_go.Response[v1.GetGroupBalancesResponse], error)
+	GetGroupSettlementPlan(context.Context, *connect_go.Request[v1.GetGroupSettlementPlanRequest]) (*connect_go.Response[v1.GetGroupSettlementPlanResponse], error)
+	RecordGroupSettlement(context.Context, *connect_go.Request[v1.RecordGroupSettlementRequest]) (*connect_go.Response[v1.RecordGroupSettlementResponse], error)
+}
+
+// NewGroupServiceClient constructs a client for the dolgovnya.group.v1.GroupService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGroupServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) GroupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &groupServiceClient{
+		createGroup: connect_go.NewClient[v1.CreateGroupRequest, v1.CreateGroupResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/CreateGroup",
+			opts...,
+		),
+		getGroup: connect_go.NewClient[v1.GetGroupRequest, v1.GetGroupResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/GetGroup",
+			opts...,
+		),
+		listGroups: connect_go.NewClient[v1.ListGroupsRequest, v1.ListGroupsResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/ListGroups",
+			opts...,
+		),
+		addGroupMember: connect_go.NewClient[v1.AddGroupMemberRequest, v1.AddGroupMemberResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/AddGroupMember",
+			opts...,
+		),
+		updateGroupMember: connect_go.NewClient[v1.UpdateGroupMemberRequest, v1.UpdateGroupMemberResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/UpdateGroupMember",
+			opts...,
+		),
+		removeGroupMember: connect_go.NewClient[v1.RemoveGroupMemberRequest, v1.RemoveGroupMemberResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/RemoveGroupMember",
+			opts...,
+		),
+		getGroupBalances: connect_go.NewClient[v1.GetGroupBalancesRequest, v1.GetGroupBalancesResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/GetGroupBalances",
+			opts...,
+		),
+		getGroupSettlementPlan: connect_go.NewClient[v1.GetGroupSettlementPlanRequest, v1.GetGroupSettlementPlanResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/GetGroupSettlementPlan",
+			opts...,
+		),
+		recordGroupSettlement: connect_go.NewClient[v1.RecordGroupSettlementRequest, v1.RecordGroupSettlementResponse](
+			httpClient,
+			baseURL+"/dolgovnya.group.v1.GroupService/RecordGroupSettlement",
+			opts...,
+		),
+	}
+}
+
+// groupServiceClient implements GroupServiceClient.
+type groupServiceClient struct {
+	createGroup            *connect_go.Client[v1.CreateGroupRequest, v1.CreateGroupResponse]
+	getGroup               *connect_go.Client[v1.GetGroupRequest, v1.GetGroupResponse]
+	listGroups             *connect_go.Client[v1.ListGroupsRequest, v1.ListGroupsResponse]
+	addGroupMember         *connect_go.Client[v1.AddGroupMemberRequest, v1.AddGroupMemberResponse]
+	updateGroupMember      *connect_go.Client[v1.UpdateGroupMemberRequest, v1.UpdateGroupMemberResponse]
+	removeGroupMember      *connect_go.Client[v1.RemoveGroupMemberRequest, v1.RemoveGroupMemberResponse]
+	getGroupBalances       *connect_go.Client[v1.GetGroupBalancesRequest, v1.GetGroupBalancesResponse]
+	getGroupSettlementPlan *connect_go.Client[v1.GetGroupSettlementPlanRequest, v1.GetGroupSettlementPlanResponse]
+	recordGroupSettlement  *connect_go.Client[v1.RecordGroupSettlementRequest, v1.RecordGroupSettlementResponse]
+}
+
+// CreateGroup calls dolgovnya.group.v1.GroupService.CreateGroup.
+func (c *groupServiceClient) CreateGroup(ctx context.Context, req *connect_go.Request[v1.CreateGroupRequest]) (*connect_go.Response[v1.CreateGroupResponse], error) {
+	return c.createGroup.CallUnary(ctx, req)
+}
+
+// GetGroup calls dolgovnya.group.v1.GroupService.GetGroup.
+func (c *groupServiceClient) GetGroup(ctx context.Context, req *connect_go.Request[v1.GetGroupRequest]) (*connect_go.Response[v1.GetGroupResponse], error) {
+	return c.getGroup.CallUnary(ctx, req)
+}
+
+// ListGroups calls dolgovnya.group.v1.GroupService.ListGroups.
+func (c *groupServiceClient) ListGroups(ctx context.Context, req *connect_go.Request[v1.ListGroupsRequest]) (*connect_go.Response[v1.ListGroupsResponse], error) {
+	return c.listGroups.CallUnary(ctx, req)
+}
+
+// AddGroupMember calls dolgovnya.group.v1.GroupService.AddGroupMember.
+func (c *groupServiceClient) AddGroupMember(ctx context.Context, req *connect_go.Request[v1.AddGroupMemberRequest]) (*connect_go.Response[v1.AddGroupMemberResponse], error) {
+	return c.addGroupMember.CallUnary(ctx, req)
+}
+
+// UpdateGroupMember calls dolgovnya.group.v1.GroupService.UpdateGroupMember.
+func (c *groupServiceClient) UpdateGroupMember(ctx context.Context, req *connect_go.Request[v1.UpdateGroupMemberRequest]) (*connect_go.Response[v1.UpdateGroupMemberResponse], error) {
+	return c.updateGroupMember.CallUnary(ctx, req)
+}
+
+// RemoveGroupMember calls dolgovnya.group.v1.GroupService.RemoveGroupMember.
+func (c *groupServiceClient) RemoveGroupMember(ctx context.Context, req *connect_go.Request[v1.RemoveGroupMemberRequest]) (*connect_go.Response[v1.RemoveGroupMemberResponse], error) {
+	return c.removeGroupMember.CallUnary(ctx, req)
+}
+
+// GetGroupBalances calls dolgovnya.group.v1.GroupService.GetGroupBalances.
+func (c *groupServiceClient) GetGroupBalances(ctx context.Context, req *connect_go.Request[v1.GetGroupBalancesRequest]) (*connect_go.Response[v1.GetGroupBalancesResponse], error) {
+	return c.getGroupBalances.CallUnary(ctx, req)
+}
+
+// GetGroupSettlementPlan calls dolgovnya.group.v1.GroupService.GetGroupSettlementPlan.
+func (c *groupServiceClient) GetGroupSettlementPlan(ctx context.Context, req *connect_go.Request[v1.GetGroupSettlementPlanRequest]) (*connect_go.Response[v1.GetGroupSettlementPlanResponse], error) {
+	return c.getGroupSettlementPlan.CallUnary(ctx, req)
+}
+
+// RecordGroupSettlement calls dolgovnya.group.v1.GroupService.RecordGroupSettlement.
+func (c *groupServiceClient) RecordGroupSettlement(ctx context.Context, req *connect_go.Request[v1.RecordGroupSettlementRequest]) (*connect_go.Response[v1.RecordGroupSettlementResponse], error) {
+	return c.recordGroupSettlement.CallUnary(ctx, req)
+}
+
+// GroupServiceHandler is an implementation of the dolgovnya.group.v1.GroupService service.
+type GroupServiceHandler interface {
+	CreateGroup(context.Context, *connect_go.Request[v1.CreateGroupRequest]) (*connect_go.Response[v1.CreateGroupResponse], error)
+	GetGroup(context.Context, *connect_go.Request[v1.GetGroupRequest]) (*connect_go.Response[v1.GetGroupResponse], error)
+	ListGroups(context.Context, *connect_go.Request[v1.ListGroupsRequest]) (*connect_go.Response[v1.ListGroupsResponse], error)
+	AddGroupMember(context.Context, *connect_go.Request[v1.AddGroupMemberRequest]) (*connect_go.Response[v1.AddGroupMemberResponse], error)
+	UpdateGroupMember(context.Context, *connect_go.Request[v1.UpdateGroupMemberRequest]) (*connect_go.Response[v1.UpdateGroupMemberResponse], error)
+	RemoveGroupMember(context.Context, *connect_go.Request[v1.RemoveGroupMemberRequest]) (*connect_go.Response[v1.RemoveGroupMemberResponse], error)
+	GetGroupBalances(context.Context, *connect_go.Request[v1.GetGroupBalancesRequest]) (*connect_go.Response[v1.GetGroupBalancesResponse], error)
+	GetGroupSettlementPlan(context.Context, *connect_go.Request[v1.GetGroupSettlementPlanRequest]) (*connect_go.Response[v1.GetGroupSettlementPlanResponse], error)
+	RecordGroupSettlement(context.Context, *connect_go.Request[v1.RecordGroupSettlementRequest]) (*connect_go.Response[v1.RecordGroupSettlementResponse], error)
+}
+
+// NewGroupServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGroupServiceHandler(svc GroupServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/dolgovnya.group.v1.GroupService/CreateGroup", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/CreateGroup",
+		svc.CreateGroup,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/GetGroup", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/GetGroup",
+		svc.GetGroup,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/ListGroups", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/ListGroups",
+		svc.ListGroups,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/AddGroupMember", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/AddGroupMember",
+		svc.AddGroupMember,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/UpdateGroupMember", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/UpdateGroupMember",
+		svc.UpdateGroupMember,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/RemoveGroupMember", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/RemoveGroupMember",
+		svc.RemoveGroupMember,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/GetGroupBalances", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/GetGroupBalances",
+		svc.GetGroupBalances,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/GetGroupSettlementPlan", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/GetGroupSettlementPlan",
+		svc.GetGroupSettlementPlan,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.group.v1.GroupService/RecordGroupSettlement", connect_go.NewUnaryHandler(
+		"/dolgovnya.group.v1.GroupService/RecordGroupSettlement",
+		svc.RecordGroupSettlement,
+		opts...,
+	))
+	return "/dolgovnya.group.v1.GroupService/", mux
+}
+
+// UnimplementedGroupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGroupServiceHandler struct{}
+
+func (UnimplementedGroupServiceHandler) CreateGroup(context.Context, *connect_go.Request[v1.CreateGroupRequest]) (*connect_go.Response[v1.CreateGroupResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.CreateGroup is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) GetGroup(context.Context, *connect_go.Request[v1.GetGroupRequest]) (*connect_go.Response[v1.GetGroupResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.GetGroup is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) ListGroups(context.Context, *connect_go.Request[v1.ListGroupsRequest]) (*connect_go.Response[v1.ListGroupsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.ListGroups is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) AddGroupMember(context.Context, *connect_go.Request[v1.AddGroupMemberRequest]) (*connect_go.Response[v1.AddGroupMemberResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.AddGroupMember is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) UpdateGroupMember(context.Context, *connect_go.Request[v1.UpdateGroupMemberRequest]) (*connect_go.Response[v1.UpdateGroupMemberResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.UpdateGroupMember is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) RemoveGroupMember(context.Context, *connect_go.Request[v1.RemoveGroupMemberRequest]) (*connect_go.Response[v1.RemoveGroupMemberResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.RemoveGroupMember is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) GetGroupBalances(context.Context, *connect_go.Request[v1.GetGroupBalancesRequest]) (*connect_go.Response[v1.GetGroupBalancesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.GetGroupBalances is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) GetGroupSettlementPlan(context.Context, *connect_go.Request[v1.GetGroupSettlementPlanRequest]) (*connect_go.Response[v1.GetGroupSettlementPlanResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.GetGroupSettlementPlan is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) RecordGroupSettlement(context.Context, *connect_go.Request[v1.RecordGroupSettlementRequest]) (*connect_go.Response[v1.RecordGroupSettlementResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.group.v1.GroupService.RecordGroupSettlement is not implemented"))
+}
@@ -1,219 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.30.0
-// 	protoc        (unknown)
-// source: dolgovnya/internal/v1/internal.proto
-
-package internalv1
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type NewUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id int64 `protobuf:"zigzag64,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *NewUserResponse) Reset() {
-	*x = NewUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_dolgovnya_internal_v1_internal_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *NewUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NewUserResponse) ProtoMessage() {}
-
-func (x *NewUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dolgovnya_internal_v1_internal_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NewUserResponse.ProtoReflect.Descriptor instead.
-func (*NewUserResponse) Descriptor() ([]byte, []int) {
-	return file_dolgovnya_internal_v1_internal_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *NewUserResponse) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type NewUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *NewUserRequest) Reset() {
-	*x = NewUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_dolgovnya_internal_v1_internal_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *NewUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*NewUserRequest) ProtoMessage() {}
-
-func (x *NewUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dolgovnya_internal_v1_internal_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NewUserRequest.ProtoReflect.Descriptor instead.
-func (*NewUserRequest) Descriptor() ([]byte, []int) {
-	return file_dolgovnya_internal_v1_internal_proto_rawDescGZIP(), []int{1}
-}
-
-var File_dolgovnya_internal_v1_internal_proto protoreflect.FileDescriptor
-
-var file_dolgovnya_internal_v1_internal_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
-	0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x22, 0x21, 0x0a,
-	0x0f, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x10, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x32, 0x6b, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
-	0x6e, 0x79, 0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0xf3, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
-	0x61, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x6c, 0x61, 0x6d, 0x4a,
-	0x61, 0x6d, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2d, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62,
-	0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x44, 0x49, 0x58, 0xaa, 0x02, 0x15, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
-	0x6e, 0x79, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x15, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
-	0x6e, 0x79, 0x61, 0x5c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x44, 0x6f,
-	0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x3a, 0x3a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_dolgovnya_internal_v1_internal_proto_rawDescOnce sync.Once
-	file_dolgovnya_internal_v1_internal_proto_rawDescData = file_dolgovnya_internal_v1_internal_proto_rawDesc
-)
-
-func file_dolgovnya_internal_v1_internal_proto_rawDescGZIP() []byte {
-	file_dolgovnya_internal_v1_internal_proto_rawDescOnce.Do(func() {
-		file_dolgovnya_internal_v1_internal_proto_rawDescData = protoimpl.X.CompressGZIP(file_dolgovnya_internal_v1_internal_proto_rawDescData)
-	})
-	return file_dolgovnya_internal_v1_internal_proto_rawDescData
-}
-
-var file_dolgovnya_internal_v1_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_dolgovnya_internal_v1_internal_proto_goTypes = []interface{}{
-	(*NewUserResponse)(nil), // 0: dolgovnya.internal.v1.NewUserResponse
-	(*NewUserRequest)(nil),  // 1: dolgovnya.internal.v1.NewUserRequest
-}
-var file_dolgovnya_internal_v1_internal_proto_depIdxs = []int32{
-	1, // 0: dolgovnya.internal.v1.InternalService.NewUser:input_type -> dolgovnya.internal.v1.NewUserRequest
-	0, // 1: dolgovnya.internal.v1.InternalService.NewUser:output_type -> dolgovnya.internal.v1.NewUserResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_dolgovnya_internal_v1_internal_proto_init() }
-func file_dolgovnya_internal_v1_internal_proto_init() {
-	if File_dolgovnya_internal_v1_internal_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_dolgovnya_internal_v1_internal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewUserResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_dolgovnya_internal_v1_internal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewUserRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_dolgovnya_internal_v1_internal_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_dolgovnya_internal_v1_internal_proto_goTypes,
-		DependencyIndexes: file_dolgovnya_internal_v1_internal_proto_depIdxs,
-		MessageInfos:      file_dolgovnya_internal_v1_internal_proto_msgTypes,
-	}.Build()
-	File_dolgovnya_internal_v1_internal_proto = out.File
-	file_dolgovnya_internal_v1_internal_proto_rawDesc = nil
-	file_dolgovnya_internal_v1_internal_proto_goTypes = nil
-	file_dolgovnya_internal_v1_internal_proto_depIdxs = nil
-}
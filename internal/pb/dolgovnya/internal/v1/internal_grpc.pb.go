@@ -1,109 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.3.0
-// - protoc             (unknown)
-// source: dolgovnya/internal/v1/internal.proto
-
-package internalv1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
-
-const (
-	InternalService_NewUser_FullMethodName = "/dolgovnya.internal.v1.InternalService/NewUser"
-)
-
-// InternalServiceClient is the client API for InternalService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type InternalServiceClient interface {
-	NewUser(ctx context.Context, in *NewUserRequest, opts ...grpc.CallOption) (*NewUserResponse, error)
-}
-
-type internalServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewInternalServiceClient(cc grpc.ClientConnInterface) InternalServiceClient {
-	return &internalServiceClient{cc}
-}
-
-func (c *internalServiceClient) NewUser(ctx context.Context, in *NewUserRequest, opts ...grpc.CallOption) (*NewUserResponse, error) {
-	out := new(NewUserResponse)
-	err := c.cc.Invoke(ctx, InternalService_NewUser_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// InternalServiceServer is the server API for InternalService service.
-// All implementations must embed UnimplementedInternalServiceServer
-// for forward compatibility
-type InternalServiceServer interface {
-	NewUser(context.Context, *NewUserRequest) (*NewUserResponse, error)
-	mustEmbedUnimplementedInternalServiceServer()
-}
-
-// UnimplementedInternalServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedInternalServiceServer struct {
-}
-
-func (UnimplementedInternalServiceServer) NewUser(context.Context, *NewUserRequest) (*NewUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewUser not implemented")
-}
-func (UnimplementedInternalServiceServer) mustEmbedUnimplementedInternalServiceServer() {}
-
-// UnsafeInternalServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InternalServiceServer will
-// result in compilation errors.
-type UnsafeInternalServiceServer interface {
-	mustEmbedUnimplementedInternalServiceServer()
-}
-
-func RegisterInternalServiceServer(s grpc.ServiceRegistrar, srv InternalServiceServer) {
-	s.RegisterService(&InternalService_ServiceDesc, srv)
-}
-
-func _InternalService_NewUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(InternalServiceServer).NewUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: InternalService_NewUser_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServiceServer).NewUser(ctx, req.(*NewUserRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// InternalService_ServiceDesc is the grpc.ServiceDesc for InternalService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var InternalService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "dolgovnya.internal.v1.InternalService",
-	HandlerType: (*InternalServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "NewUser",
-			Handler:    _InternalService_NewUser_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dolgovnya/internal/v1/internal.proto",
-}
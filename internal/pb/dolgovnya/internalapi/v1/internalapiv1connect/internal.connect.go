@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dolgovnya/internalapi/v1/internal.proto
+
+package internalapiv1connect
+
+import (
+	context "context"
+	errors "errors"
+	v1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/internalapi/v1"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// InternalServiceName is the fully-qualified name of the InternalService service.
+	InternalServiceName = "dolgovnya.internalapi.v1.InternalService"
+)
+
+// InternalServiceClient is a client for the dolgovnya.internalapi.v1.InternalService service.
+type InternalServiceClient interface {
+	NewUser(context.Context, *connect_go.Request[v1.NewUserRequest]) (*connect_go.Response[v1.NewUserResponse], error)
+	GetUser(context.Context, *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error)
+	// Переименование.
+	UpdateUser(context.Context, *connect_go.Request[v1.UpdateUserRequest]) (*connect_go.Response[v1.UpdateUserResponse], error)
+	SearchUsers(context.Context, *connect_go.Request[v1.SearchUsersRequest]) (*connect_go.Response[v1.SearchUsersResponse], error)
+	// Повторная деактивация ничего не меняет.
+	DeactivateUser(context.Context, *connect_go.Request[v1.DeactivateUserRequest]) (*connect_go.Response[v1.DeactivateUserResponse], error)
+	MergeUsers(context.Context, *connect_go.Request[v1.MergeUsersRequest]) (*connect_go.Response[v1.MergeUsersResponse], error)
+}
+
+// NewInternalServiceClient constructs a client for the dolgovnya.internalapi.v1.InternalService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInternalServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) InternalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &internalServiceClient{
+		newUser: connect_go.NewClient[v1.NewUserRequest, v1.NewUserResponse](
+			httpClient,
+			baseURL+"/dolgovnya.internalapi.v1.InternalService/NewUser",
+			opts...,
+		),
+		getUser: connect_go.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+"/dolgovnya.internalapi.v1.InternalService/GetUser",
+			opts...,
+		),
+		updateUser: connect_go.NewClient[v1.UpdateUserRequest, v1.UpdateUserResponse](
+			httpClient,
+			baseURL+"/dolgovnya.internalapi.v1.InternalService/UpdateUser",
+			opts...,
+		),
+		searchUsers: connect_go.NewClient[v1.SearchUsersRequest, v1.SearchUsersResponse](
+			httpClient,
+			baseURL+"/dolgovnya.internalapi.v1.InternalService/SearchUsers",
+			opts...,
+		),
+		deactivateUser: connect_go.NewClient[v1.DeactivateUserRequest, v1.DeactivateUserResponse](
+			httpClient,
+			baseURL+"/dolgovnya.internalapi.v1.InternalService/DeactivateUser",
+			opts...,
+		),
+		mergeUsers: connect_go.NewClient[v1.MergeUsersRequest, v1.MergeUsersResponse](
+			httpClient,
+			baseURL+"/dolgovnya.internalapi.v1.InternalService/MergeUsers",
+			opts...,
+		),
+	}
+}
+
+// internalServiceClient implements InternalServiceClient.
+type internalServiceClient struct {
+	newUser        *connect_go.Client[v1.NewUserRequest, v1.NewUserResponse]
+	getUser        *connect_go.Client[v1.GetUserRequest, v1.GetUserResponse]
+	updateUser     *connect_go.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	searchUsers    *connect_go.Client[v1.SearchUsersRequest, v1.SearchUsersResponse]
+	deactivateUser *connect_go.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	mergeUsers     *connect_go.Client[v1.MergeUsersRequest, v1.MergeUsersResponse]
+}
+
+// NewUser calls dolgovnya.internalapi.v1.InternalService.NewUser.
+func (c *internalServiceClient) NewUser(ctx context.Context, req *connect_go.Request[v1.NewUserRequest]) (*connect_go.Response[v1.NewUserResponse], error) {
+	return c.newUser.CallUnary(ctx, req)
+}
+
+// GetUser calls dolgovnya.internalapi.v1.InternalService.GetUser.
+func (c *internalServiceClient) GetUser(ctx context.Context, req *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// UpdateUser calls dolgovnya.internalapi.v1.InternalService.UpdateUser.
+func (c *internalServiceClient) UpdateUser(ctx context.Context, req *connect_go.Request[v1.UpdateUserRequest]) (*connect_go.Response[v1.UpdateUserResponse], error) {
+	return c.updateUser.CallUnary(ctx, req)
+}
+
+// SearchUsers calls dolgovnya.internalapi.v1.InternalService.SearchUsers.
+func (c *internalServiceClient) SearchUsers(ctx context.Context, req *connect_go.Request[v1.SearchUsersRequest]) (*connect_go.Response[v1.SearchUsersResponse], error) {
+	return c.searchUsers.CallUnary(ctx, req)
+}
+
+// DeactivateUser calls dolgovnya.internalapi.v1.InternalService.DeactivateUser.
+func (c *internalServiceClient) DeactivateUser(ctx context.Context, req *connect_go.Request[v1.DeactivateUserRequest]) (*connect_go.Response[v1.DeactivateUserResponse], error) {
+	return c.deactivateUser.CallUnary(ctx, req)
+}
+
+// MergeUsers calls dolgovnya.internalapi.v1.InternalService.MergeUsers.
+func (c *internalServiceClient) MergeUsers(ctx context.Context, req *connect_go.Request[v1.MergeUsersRequest]) (*connect_go.Response[v1.MergeUsersResponse], error) {
+	return c.mergeUsers.CallUnary(ctx, req)
+}
+
+// InternalServiceHandler is an implementation of the dolgovnya.internalapi.v1.InternalService
+// service.
+type InternalServiceHandler interface {
+	NewUser(context.Context, *connect_go.Request[v1.NewUserRequest]) (*connect_go.Response[v1.NewUserResponse], error)
+	GetUser(context.Context, *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error)
+	// Переименование.
+	UpdateUser(context.Context, *connect_go.Request[v1.UpdateUserRequest]) (*connect_go.Response[v1.UpdateUserResponse], error)
+	SearchUsers(context.Context, *connect_go.Request[v1.SearchUsersRequest]) (*connect_go.Response[v1.SearchUsersResponse], error)
+	// Повторная деактивация ничего не меняет.
+	DeactivateUser(context.Context, *connect_go.Request[v1.DeactivateUserRequest]) (*connect_go.Response[v1.DeactivateUserResponse], error)
+	MergeUsers(context.Context, *connect_go.Request[v1.MergeUsersRequest]) (*connect_go.Response[v1.MergeUsersResponse], error)
+}
+
+// NewInternalServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInternalServiceHandler(svc InternalServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/dolgovnya.internalapi.v1.InternalService/NewUser", connect_go.NewUnaryHandler(
+		"/dolgovnya.internalapi.v1.InternalService/NewUser",
+		svc.NewUser,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.internalapi.v1.InternalService/GetUser", connect_go.NewUnaryHandler(
+		"/dolgovnya.internalapi.v1.InternalService/GetUser",
+		svc.GetUser,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.internalapi.v1.InternalService/UpdateUser", connect_go.NewUnaryHandler(
+		"/dolgovnya.internalapi.v1.InternalService/UpdateUser",
+		svc.UpdateUser,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.internalapi.v1.InternalService/SearchUsers", connect_go.NewUnaryHandler(
+		"/dolgovnya.internalapi.v1.InternalService/SearchUsers",
+		svc.SearchUsers,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.internalapi.v1.InternalService/DeactivateUser", connect_go.NewUnaryHandler(
+		"/dolgovnya.internalapi.v1.InternalService/DeactivateUser",
+		svc.DeactivateUser,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.internalapi.v1.InternalService/MergeUsers", connect_go.NewUnaryHandler(
+		"/dolgovnya.internalapi.v1.InternalService/MergeUsers",
+		svc.MergeUsers,
+		opts...,
+	))
+	return "/dolgovnya.internalapi.v1.InternalService/", mux
+}
+
+// UnimplementedInternalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInternalServiceHandler struct{}
+
+func (UnimplementedInternalServiceHandler) NewUser(context.Context, *connect_go.Request[v1.NewUserRequest]) (*connect_go.Response[v1.NewUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.internalapi.v1.InternalService.NewUser is not implemented"))
+}
+
+func (UnimplementedInternalServiceHandler) GetUser(context.Context, *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.internalapi.v1.InternalService.GetUser is not implemented"))
+}
+
+func (UnimplementedInternalServiceHandler) UpdateUser(context.Context, *connect_go.Request[v1.UpdateUserRequest]) (*connect_go.Response[v1.UpdateUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.internalapi.v1.InternalService.UpdateUser is not implemented"))
+}
+
+func (UnimplementedInternalServiceHandler) SearchUsers(context.Context, *connect_go.Request[v1.SearchUsersRequest]) (*connect_go.Response[v1.SearchUsersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.internalapi.v1.InternalService.SearchUsers is not implemented"))
+}
+
+func (UnimplementedInternalServiceHandler) DeactivateUser(context.Context, *connect_go.Request[v1.DeactivateUserRequest]) (*connect_go.Response[v1.DeactivateUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.internalapi.v1.InternalService.DeactivateUser is not implemented"))
+}
+
+func (UnimplementedInternalServiceHandler) MergeUsers(context.Context, *connect_go.Request[v1.MergeUsersRequest]) (*connect_go.Response[v1.MergeUsersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.internalapi.v1.InternalService.MergeUsers is not implemented"))
+}
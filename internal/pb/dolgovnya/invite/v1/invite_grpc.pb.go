@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: dolgovnya/invite/v1/invite.proto
+
+package invitev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	InviteService_CreatePlaceholder_FullMethodName = "/dolgovnya.invite.v1.InviteService/CreatePlaceholder"
+	InviteService_CreateInvite_FullMethodName      = "/dolgovnya.invite.v1.InviteService/CreateInvite"
+	InviteService_GetInvite_FullMethodName         = "/dolgovnya.invite.v1.InviteService/GetInvite"
+	InviteService_ClaimInvite_FullMethodName       = "/dolgovnya.invite.v1.InviteService/ClaimInvite"
+)
+
+// InviteServiceClient is the client API for InviteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InviteServiceClient interface {
+	CreatePlaceholder(ctx context.Context, in *CreatePlaceholderRequest, opts ...grpc.CallOption) (*CreatePlaceholderResponse, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
+	GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*GetInviteResponse, error)
+	ClaimInvite(ctx context.Context, in *ClaimInviteRequest, opts ...grpc.CallOption) (*ClaimInviteResponse, error)
+}
+
+type inviteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInviteServiceClient(cc grpc.ClientConnInterface) InviteServiceClient {
+	return &inviteServiceClient{cc}
+}
+
+func (c *inviteServiceClient) CreatePlaceholder(ctx context.Context, in *CreatePlaceholderRequest, opts ...grpc.CallOption) (*CreatePlaceholderResponse, error) {
+	out := new(CreatePlaceholderResponse)
+	err := c.cc.Invoke(ctx, InviteService_CreatePlaceholder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error) {
+	out := new(CreateInviteResponse)
+	err := c.cc.Invoke(ctx, InviteService_CreateInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*GetInviteResponse, error) {
+	out := new(GetInviteResponse)
+	err := c.cc.Invoke(ctx, InviteService_GetInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inviteServiceClient) ClaimInvite(ctx context.Context, in *ClaimInviteRequest, opts ...grpc.CallOption) (*ClaimInviteResponse, error) {
+	out := new(ClaimInviteResponse)
+	err := c.cc.Invoke(ctx, InviteService_ClaimInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InviteServiceServer is the server API for InviteService service.
+// All implementations must embed UnimplementedInviteServiceServer
+// for forward compatibility
+type InviteServiceServer interface {
+	CreatePlaceholder(context.Context, *CreatePlaceholderRequest) (*CreatePlaceholderResponse, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
+	GetInvite(context.Context, *GetInviteRequest) (*GetInviteResponse, error)
+	ClaimInvite(context.Context, *ClaimInviteRequest) (*ClaimInviteResponse, error)
+	mustEmbedUnimplementedInviteServiceServer()
+}
+
+// UnimplementedInviteServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInviteServiceServer struct {
+}
+
+func (UnimplementedInviteServiceServer) CreatePlaceholder(context.Context, *CreatePlaceholderRequest) (*CreatePlaceholderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaceholder not implemented")
+}
+func (UnimplementedInviteServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedInviteServiceServer) GetInvite(context.Context, *GetInviteRequest) (*GetInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvite not implemented")
+}
+func (UnimplementedInviteServiceServer) ClaimInvite(context.Context, *ClaimInviteRequest) (*ClaimInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInvite not implemented")
+}
+func (UnimplementedInviteServiceServer) mustEmbedUnimplementedInviteServiceServer() {}
+
+// UnsafeInviteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InviteServiceServer will
+// result in compilation errors.
+type UnsafeInviteServiceServer interface {
+	mustEmbedUnimplementedInviteServiceServer()
+}
+
+func RegisterInviteServiceServer(s grpc.ServiceRegistrar, srv InviteServiceServer) {
+	s.RegisterService(&InviteService_ServiceDesc, srv)
+}
+
+func _InviteService_CreatePlaceholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaceholderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).CreatePlaceholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_CreatePlaceholder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).CreatePlaceholder(ctx, req.(*CreatePlaceholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_GetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).GetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_GetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).GetInvite(ctx, req.(*GetInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InviteService_ClaimInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InviteServiceServer).ClaimInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InviteService_ClaimInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InviteServiceServer).ClaimInvite(ctx, req.(*ClaimInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InviteService_ServiceDesc is the grpc.ServiceDesc for InviteService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InviteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dolgovnya.invite.v1.InviteService",
+	HandlerType: (*InviteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlaceholder",
+			Handler:    _InviteService_CreatePlaceholder_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _InviteService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "GetInvite",
+			Handler:    _InviteService_GetInvite_Handler,
+		},
+		{
+			MethodName: "ClaimInvite",
+			Handler:    _InviteService_ClaimInvite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dolgovnya/invite/v1/invite.proto",
+}
@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dolgovnya/invite/v1/invite.proto
+
+package invitev1connect
+
+import (
+	context "context"
+	errors "errors"
+	v1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/invite/v1"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// InviteServiceName is the fully-qualified name of the InviteService service.
+	InviteServiceName = "dolgovnya.invite.v1.InviteService"
+)
+
+// InviteServiceClient is a client for the dolgovnya.invite.v1.InviteService service.
+type InviteServiceClient interface {
+	CreatePlaceholder(context.Context, *connect_go.Request[v1.CreatePlaceholderRequest]) (*connect_go.Response[v1.CreatePlaceholderResponse], error)
+	CreateInvite(context.Context, *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error)
+	GetInvite(context.Context, *connect_go.Request[v1.GetInviteRequest]) (*connect_go.Response[v1.GetInviteResponse], error)
+	ClaimInvite(context.Context, *connect_go.Request[v1.ClaimInviteRequest]) (*connect_go.Response[v1.ClaimInviteResponse], error)
+}
+
+// NewInviteServiceClient constructs a client for the dolgovnya.invite.v1.InviteService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInviteServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) InviteServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &inviteServiceClient{
+		createPlaceholder: connect_go.NewClient[v1.CreatePlaceholderRequest, v1.CreatePlaceholderResponse](
+			httpClient,
+			baseURL+"/dolgovnya.invite.v1.InviteService/CreatePlaceholder",
+			opts...,
+		),
+		createInvite: connect_go.NewClient[v1.CreateInviteRequest, v1.CreateInviteResponse](
+			httpClient,
+			baseURL+"/dolgovnya.invite.v1.InviteService/CreateInvite",
+			opts...,
+		),
+		getInvite: connect_go.NewClient[v1.GetInviteRequest, v1.GetInviteResponse](
+			httpClient,
+			baseURL+"/dolgovnya.invite.v1.InviteService/GetInvite",
+			opts...,
+		),
+		claimInvite: connect_go.NewClient[v1.ClaimInviteRequest, v1.ClaimInviteResponse](
+			httpClient,
+			baseURL+"/dolgovnya.invite.v1.InviteService/ClaimInvite",
+			opts...,
+		),
+	}
+}
+
+// inviteServiceClient implements InviteServiceClient.
+type inviteServiceClient struct {
+	createPlaceholder *connect_go.Client[v1.CreatePlaceholderRequest, v1.CreatePlaceholderResponse]
+	createInvite      *connect_go.Client[v1.CreateInviteRequest, v1.CreateInviteResponse]
+	getInvite         *connect_go.Client[v1.GetInviteRequest, v1.GetInviteResponse]
+	claimInvite       *connect_go.Client[v1.ClaimInviteRequest, v1.ClaimInviteResponse]
+}
+
+// CreatePlaceholder calls dolgovnya.invite.v1.InviteService.CreatePlaceholder.
+func (c *inviteServiceClient) CreatePlaceholder(ctx context.Context, req *connect_go.Request[v1.CreatePlaceholderRequest]) (*connect_go.Response[v1.CreatePlaceholderResponse], error) {
+	return c.createPlaceholder.CallUnary(ctx, req)
+}
+
+// CreateInvite calls dolgovnya.invite.v1.InviteService.CreateInvite.
+func (c *inviteServiceClient) CreateInvite(ctx context.Context, req *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error) {
+	return c.createInvite.CallUnary(ctx, req)
+}
+
+// GetInvite calls dolgovnya.invite.v1.InviteService.GetInvite.
+func (c *inviteServiceClient) GetInvite(ctx context.Context, req *connect_go.Request[v1.GetInviteRequest]) (*connect_go.Response[v1.GetInviteResponse], error) {
+	return c.getInvite.CallUnary(ctx, req)
+}
+
+// ClaimInvite calls dolgovnya.invite.v1.InviteService.ClaimInvite.
+func (c *inviteServiceClient) ClaimInvite(ctx context.Context, req *connect_go.Request[v1.ClaimInviteRequest]) (*connect_go.Response[v1.ClaimInviteResponse], error) {
+	return c.claimInvite.CallUnary(ctx, req)
+}
+
+// InviteServiceHandler is an implementation of the dolgovnya.invite.v1.InviteService service.
+type InviteServiceHandler interface {
+	CreatePlaceholder(context.Context, *connect_go.Request[v1.CreatePlaceholderRequest]) (*connect_go.Response[v1.CreatePlaceholderResponse], error)
+	CreateInvite(context.Context, *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error)
+	GetInvite(context.Context, *connect_go.Request[v1.GetInviteRequest]) (*connect_go.Response[v1.GetInviteResponse], error)
+	ClaimInvite(context.Context, *connect_go.Request[v1.ClaimInviteRequest]) (*connect_go.Response[v1.ClaimInviteResponse], error)
+}
+
+// NewInviteServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInviteServiceHandler(svc InviteServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/dolgovnya.invite.v1.InviteService/CreatePlaceholder", connect_go.NewUnaryHandler(
+		"/dolgovnya.invite.v1.InviteService/CreatePlaceholder",
+		svc.CreatePlaceholder,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.invite.v1.InviteService/CreateInvite", connect_go.NewUnaryHandler(
+		"/dolgovnya.invite.v1.InviteService/CreateInvite",
+		svc.CreateInvite,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.invite.v1.InviteService/GetInvite", connect_go.NewUnaryHandler(
+		"/dolgovnya.invite.v1.InviteService/GetInvite",
+		svc.GetInvite,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.invite.v1.InviteService/ClaimInvite", connect_go.NewUnaryHandler(
+		"/dolgovnya.invite.v1.InviteService/ClaimInvite",
+		svc.ClaimInvite,
+		opts...,
+	))
+	return "/dolgovnya.invite.v1.InviteService/", mux
+}
+
+// UnimplementedInviteServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInviteServiceHandler struct{}
+
+func (UnimplementedInviteServiceHandler) CreatePlaceholder(context.Context, *connect_go.Request[v1.CreatePlaceholderRequest]) (*connect_go.Response[v1.CreatePlaceholderResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.invite.v1.InviteService.CreatePlaceholder is not implemented"))
+}
+
+func (UnimplementedInviteServiceHandler) CreateInvite(context.Context, *connect_go.Request[v1.CreateInviteRequest]) (*connect_go.Response[v1.CreateInviteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.invite.v1.InviteService.CreateInvite is not implemented"))
+}
+
+func (UnimplementedInviteServiceHandler) GetInvite(context.Context, *connect_go.Request[v1.GetInviteRequest]) (*connect_go.Response[v1.GetInviteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.invite.v1.InviteService.GetInvite is not implemented"))
+}
+
+func (UnimplementedInviteServiceHandler) ClaimInvite(context.Context, *connect_go.Request[v1.ClaimInviteRequest]) (*connect_go.Response[v1.ClaimInviteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.invite.v1.InviteService.ClaimInvite is not implemented"))
+}
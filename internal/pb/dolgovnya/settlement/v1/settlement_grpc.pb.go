@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: dolgovnya/settlement/v1/settlement.proto
+
+package settlementv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SettlementService_GetSettlementPlan_FullMethodName = "/dolgovnya.settlement.v1.SettlementService/GetSettlementPlan"
+	SettlementService_RecordSettlement_FullMethodName  = "/dolgovnya.settlement.v1.SettlementService/RecordSettlement"
+	SettlementService_ListSettlements_FullMethodName   = "/dolgovnya.settlement.v1.SettlementService/ListSettlements"
+)
+
+// SettlementServiceClient is the client API for SettlementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettlementServiceClient interface {
+	GetSettlementPlan(ctx context.Context, in *GetSettlementPlanRequest, opts ...grpc.CallOption) (*GetSettlementPlanResponse, error)
+	RecordSettlement(ctx context.Context, in *RecordSettlementRequest, opts ...grpc.CallOption) (*RecordSettlementResponse, error)
+	ListSettlements(ctx context.Context, in *ListSettlementsRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error)
+}
+
+type settlementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettlementServiceClient(cc grpc.ClientConnInterface) SettlementServiceClient {
+	return &settlementServiceClient{cc}
+}
+
+func (c *settlementServiceClient) GetSettlementPlan(ctx context.Context, in *GetSettlementPlanRequest, opts ...grpc.CallOption) (*GetSettlementPlanResponse, error) {
+	out := new(GetSettlementPlanResponse)
+	err := c.cc.Invoke(ctx, SettlementService_GetSettlementPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) RecordSettlement(ctx context.Context, in *RecordSettlementRequest, opts ...grpc.CallOption) (*RecordSettlementResponse, error) {
+	out := new(RecordSettlementResponse)
+	err := c.cc.Invoke(ctx, SettlementService_RecordSettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListSettlements(ctx context.Context, in *ListSettlementsRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error) {
+	out := new(ListSettlementsResponse)
+	err := c.cc.Invoke(ctx, SettlementService_ListSettlements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettlementServiceServer is the server API for SettlementService service.
+// All implementations must embed UnimplementedSettlementServiceServer
+// for forward compatibility
+type SettlementServiceServer interface {
+	GetSettlementPlan(context.Context, *GetSettlementPlanRequest) (*GetSettlementPlanResponse, error)
+	RecordSettlement(context.Context, *RecordSettlementRequest) (*RecordSettlementResponse, error)
+	ListSettlements(context.Context, *ListSettlementsRequest) (*ListSettlementsResponse, error)
+	mustEmbedUnimplementedSettlementServiceServer()
+}
+
+// UnimplementedSettlementServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSettlementServiceServer struct {
+}
+
+func (UnimplementedSettlementServiceServer) GetSettlementPlan(context.Context, *GetSettlementPlanRequest) (*GetSettlementPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementPlan not implemented")
+}
+func (UnimplementedSettlementServiceServer) RecordSettlement(context.Context, *RecordSettlementRequest) (*RecordSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSettlement not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListSettlements(context.Context, *ListSettlementsRequest) (*ListSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlements not implemented")
+}
+func (UnimplementedSettlementServiceServer) mustEmbedUnimplementedSettlementServiceServer() {}
+
+// UnsafeSettlementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettlementServiceServer will
+// result in compilation errors.
+type UnsafeSettlementServiceServer interface {
+	mustEmbedUnimplementedSettlementServiceServer()
+}
+
+func RegisterSettlementServiceServer(s grpc.ServiceRegistrar, srv SettlementServiceServer) {
+	s.RegisterService(&SettlementService_ServiceDesc, srv)
+}
+
+func _SettlementService_GetSettlementPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettlementPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).GetSettlementPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_GetSettlementPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).GetSettlementPlan(ctx, req.(*GetSettlementPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_RecordSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).RecordSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_RecordSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).RecordSettlement(ctx, req.(*RecordSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListSettlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListSettlements(ctx, req.(*ListSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettlementService_ServiceDesc is the grpc.ServiceDesc for SettlementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettlementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dolgovnya.settlement.v1.SettlementService",
+	HandlerType: (*SettlementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSettlementPlan",
+			Handler:    _SettlementService_GetSettlementPlan_Handler,
+		},
+		{
+			MethodName: "RecordSettlement",
+			Handler:    _SettlementService_RecordSettlement_Handler,
+		},
+		{
+			MethodName: "ListSettlements",
+			Handler:    _SettlementService_ListSettlements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dolgovnya/settlement/v1/settlement.proto",
+}
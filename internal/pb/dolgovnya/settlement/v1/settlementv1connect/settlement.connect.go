@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: dolgovnya/settlement/v1/settlement.proto
+
+package settlementv1connect
+
+import (
+	context "context"
+	errors "errors"
+	v1 "github.com/SlamJam/dolgovnya-backend/internal/pb/dolgovnya/settlement/v1"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// SettlementServiceName is the fully-qualified name of the SettlementService service.
+	SettlementServiceName = "dolgovnya.settlement.v1.SettlementService"
+)
+
+// SettlementServiceClient is a client for the dolgovnya.settlement.v1.SettlementService service.
+type SettlementServiceClient interface {
+	GetSettlementPlan(context.Context, *connect_go.Request[v1.GetSettlementPlanRequest]) (*connect_go.Response[v1.GetSettlementPlanResponse], error)
+	RecordSettlement(context.Context, *connect_go.Request[v1.RecordSettlementRequest]) (*connect_go.Response[v1.RecordSettlementResponse], error)
+	ListSettlements(context.Context, *connect_go.Request[v1.ListSettlementsRequest]) (*connect_go.Response[v1.ListSettlementsResponse], error)
+}
+
+// NewSettlementServiceClient constructs a client for the dolgovnya.settlement.v1.SettlementService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSettlementServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) SettlementServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &settlementServiceClient{
+		getSettlementPlan: connect_go.NewClient[v1.GetSettlementPlanRequest, v1.GetSettlementPlanResponse](
+			httpClient,
+			baseURL+"/dolgovnya.settlement.v1.SettlementService/GetSettlementPlan",
+			opts...,
+		),
+		recordSettlement: connect_go.NewClient[v1.RecordSettlementRequest, v1.RecordSettlementResponse](
+			httpClient,
+			baseURL+"/dolgovnya.settlement.v1.SettlementService/RecordSettlement",
+			opts...,
+		),
+		listSettlements: connect_go.NewClient[v1.ListSettlementsRequest, v1.ListSettlementsResponse](
+			httpClient,
+			baseURL+"/dolgovnya.settlement.v1.SettlementService/ListSettlements",
+			opts...,
+		),
+	}
+}
+
+// settlementServiceClient implements SettlementServiceClient.
+type settlementServiceClient struct {
+	getSettlementPlan *connect_go.Client[v1.GetSettlementPlanRequest, v1.GetSettlementPlanResponse]
+	recordSettlement  *connect_go.Client[v1.RecordSettlementRequest, v1.RecordSettlementResponse]
+	listSettlements   *connect_go.Client[v1.ListSettlementsRequest, v1.ListSettlementsResponse]
+}
+
+// GetSettlementPlan calls dolgovnya.settlement.v1.SettlementService.GetSettlementPlan.
+func (c *settlementServiceClient) GetSettlementPlan(ctx context.Context, req *connect_go.Request[v1.GetSettlementPlanRequest]) (*connect_go.Response[v1.GetSettlementPlanResponse], error) {
+	return c.getSettlementPlan.CallUnary(ctx, req)
+}
+
+// RecordSettlement calls dolgovnya.settlement.v1.SettlementService.RecordSettlement.
+func (c *settlementServiceClient) RecordSettlement(ctx context.Context, req *connect_go.Request[v1.RecordSettlementRequest]) (*connect_go.Response[v1.RecordSettlementResponse], error) {
+	return c.recordSettlement.CallUnary(ctx, req)
+}
+
+// ListSettlements calls dolgovnya.settlement.v1.SettlementService.ListSettlements.
+func (c *settlementServiceClient) ListSettlements(ctx context.Context, req *connect_go.Request[v1.ListSettlementsRequest]) (*connect_go.Response[v1.ListSettlementsResponse], error) {
+	return c.listSettlements.CallUnary(ctx, req)
+}
+
+// SettlementServiceHandler is an implementation of the dolgovnya.settlement.v1.SettlementService
+// service.
+type SettlementServiceHandler interface {
+	GetSettlementPlan(context.Context, *connect_go.Request[v1.GetSettlementPlanRequest]) (*connect_go.Response[v1.GetSettlementPlanResponse], error)
+	RecordSettlement(context.Context, *connect_go.Request[v1.RecordSettlementRequest]) (*connect_go.Response[v1.RecordSettlementResponse], error)
+	ListSettlements(context.Context, *connect_go.Request[v1.ListSettlementsRequest]) (*connect_go.Response[v1.ListSettlementsResponse], error)
+}
+
+// NewSettlementServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSettlementServiceHandler(svc SettlementServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/dolgovnya.settlement.v1.SettlementService/GetSettlementPlan", connect_go.NewUnaryHandler(
+		"/dolgovnya.settlement.v1.SettlementService/GetSettlementPlan",
+		svc.GetSettlementPlan,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.settlement.v1.SettlementService/RecordSettlement", connect_go.NewUnaryHandler(
+		"/dolgovnya.settlement.v1.SettlementService/RecordSettlement",
+		svc.RecordSettlement,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.settlement.v1.SettlementService/ListSettlements", connect_go.NewUnaryHandler(
+		"/dolgovnya.settlement.v1.SettlementService/ListSettlements",
+		svc.ListSettlements,
+		opts...,
+	))
+	return "/dolgovnya.settlement.v1.SettlementService/", mux
+}
+
+// UnimplementedSettlementServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSettlementServiceHandler struct{}
+
+func (UnimplementedSettlementServiceHandler) GetSettlementPlan(context.Context, *connect_go.Request[v1.GetSettlementPlanRequest]) (*connect_go.Response[v1.GetSettlementPlanResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.settlement.v1.SettlementService.GetSettlementPlan is not implemented"))
+}
+
+func (UnimplementedSettlementServiceHandler) RecordSettlement(context.Context, *connect_go.Request[v1.RecordSettlementRequest]) (*connect_go.Response[v1.RecordSettlementResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.settlement.v1.SettlementService.RecordSettlement is not implemented"))
+}
+
+func (UnimplementedSettlementServiceHandler) ListSettlements(context.Context, *connect_go.Request[v1.ListSettlementsRequest]) (*connect_go.Response[v1.ListSettlementsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.settlement.v1.SettlementService.ListSettlements is not implemented"))
+}
@@ -11,6 +11,7 @@ import (
 	money "google.golang.org/genproto/googleapis/type/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,13 +23,250 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Кому достаётся остаток от округления долей до минимальной единицы валюты.
+type RoundingStrategy int32
+
+const (
+	// По умолчанию - ROUNDING_STRATEGY_LARGEST_REMAINDER.
+	RoundingStrategy_ROUNDING_STRATEGY_UNSPECIFIED RoundingStrategy = 0
+	// Метод наибольших остатков.
+	RoundingStrategy_ROUNDING_STRATEGY_LARGEST_REMAINDER RoundingStrategy = 1
+	// Должники платят с округлением вниз, остаток теряют те, кто платил.
+	RoundingStrategy_ROUNDING_STRATEGY_PAYER_ABSORBS RoundingStrategy = 2
+	// Округление по долгам владельца счёта - не в его пользу.
+	RoundingStrategy_ROUNDING_STRATEGY_OWNER_ABSORBS RoundingStrategy = 3
+	// По очереди в порядке user_id должника.
+	RoundingStrategy_ROUNDING_STRATEGY_ROUND_ROBIN RoundingStrategy = 4
+)
+
+// Enum value maps for RoundingStrategy.
+var (
+	RoundingStrategy_name = map[int32]string{
+		0: "ROUNDING_STRATEGY_UNSPECIFIED",
+		1: "ROUNDING_STRATEGY_LARGEST_REMAINDER",
+		2: "ROUNDING_STRATEGY_PAYER_ABSORBS",
+		3: "ROUNDING_STRATEGY_OWNER_ABSORBS",
+		4: "ROUNDING_STRATEGY_ROUND_ROBIN",
+	}
+	RoundingStrategy_value = map[string]int32{
+		"ROUNDING_STRATEGY_UNSPECIFIED":       0,
+		"ROUNDING_STRATEGY_LARGEST_REMAINDER": 1,
+		"ROUNDING_STRATEGY_PAYER_ABSORBS":     2,
+		"ROUNDING_STRATEGY_OWNER_ABSORBS":     3,
+		"ROUNDING_STRATEGY_ROUND_ROBIN":       4,
+	}
+)
+
+func (x RoundingStrategy) Enum() *RoundingStrategy {
+	p := new(RoundingStrategy)
+	*p = x
+	return p
+}
+
+func (x RoundingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[0].Descriptor()
+}
+
+func (RoundingStrategy) Type() protoreflect.EnumType {
+	return &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[0]
+}
+
+func (x RoundingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundingStrategy.Descriptor instead.
+func (RoundingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{0}
+}
+
+// Как задана доля в позиции. Сначала из цены позиции вычитаются суммы и
+// проценты, остаток делится по весам или поровну.
+type ShareMode int32
+
+const (
+	// По умолчанию - SHARE_MODE_WEIGHT.
+	ShareMode_SHARE_MODE_UNSPECIFIED ShareMode = 0
+	// Относительный вес share.
+	ShareMode_SHARE_MODE_WEIGHT ShareMode = 1
+	// Фиксированная сумма amount.
+	ShareMode_SHARE_MODE_AMOUNT ShareMode = 2
+	// percent процентов от цены позиции.
+	ShareMode_SHARE_MODE_PERCENT ShareMode = 3
+	// Поровну из того, что осталось после сумм и процентов.
+	ShareMode_SHARE_MODE_REMAINDER ShareMode = 4
+)
+
+// Enum value maps for ShareMode.
+var (
+	ShareMode_name = map[int32]string{
+		0: "SHARE_MODE_UNSPECIFIED",
+		1: "SHARE_MODE_WEIGHT",
+		2: "SHARE_MODE_AMOUNT",
+		3: "SHARE_MODE_PERCENT",
+		4: "SHARE_MODE_REMAINDER",
+	}
+	ShareMode_value = map[string]int32{
+		"SHARE_MODE_UNSPECIFIED": 0,
+		"SHARE_MODE_WEIGHT":      1,
+		"SHARE_MODE_AMOUNT":      2,
+		"SHARE_MODE_PERCENT":     3,
+		"SHARE_MODE_REMAINDER":   4,
+	}
+)
+
+func (x ShareMode) Enum() *ShareMode {
+	p := new(ShareMode)
+	*p = x
+	return p
+}
+
+func (x ShareMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[1].Descriptor()
+}
+
+func (ShareMode) Type() protoreflect.EnumType {
+	return &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[1]
+}
+
+func (x ShareMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareMode.Descriptor instead.
+func (ShareMode) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{1}
+}
+
+type AdjustmentKind int32
+
+const (
+	AdjustmentKind_ADJUSTMENT_KIND_UNSPECIFIED    AdjustmentKind = 0
+	AdjustmentKind_ADJUSTMENT_KIND_TIP            AdjustmentKind = 1
+	AdjustmentKind_ADJUSTMENT_KIND_TAX            AdjustmentKind = 2
+	AdjustmentKind_ADJUSTMENT_KIND_SERVICE_CHARGE AdjustmentKind = 3
+	// Уменьшает счёт.
+	AdjustmentKind_ADJUSTMENT_KIND_DISCOUNT AdjustmentKind = 4
+)
+
+// Enum value maps for AdjustmentKind.
+var (
+	AdjustmentKind_name = map[int32]string{
+		0: "ADJUSTMENT_KIND_UNSPECIFIED",
+		1: "ADJUSTMENT_KIND_TIP",
+		2: "ADJUSTMENT_KIND_TAX",
+		3: "ADJUSTMENT_KIND_SERVICE_CHARGE",
+		4: "ADJUSTMENT_KIND_DISCOUNT",
+	}
+	AdjustmentKind_value = map[string]int32{
+		"ADJUSTMENT_KIND_UNSPECIFIED":    0,
+		"ADJUSTMENT_KIND_TIP":            1,
+		"ADJUSTMENT_KIND_TAX":            2,
+		"ADJUSTMENT_KIND_SERVICE_CHARGE": 3,
+		"ADJUSTMENT_KIND_DISCOUNT":       4,
+	}
+)
+
+func (x AdjustmentKind) Enum() *AdjustmentKind {
+	p := new(AdjustmentKind)
+	*p = x
+	return p
+}
+
+func (x AdjustmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdjustmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[2].Descriptor()
+}
+
+func (AdjustmentKind) Type() protoreflect.EnumType {
+	return &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[2]
+}
+
+func (x AdjustmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdjustmentKind.Descriptor instead.
+func (AdjustmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{2}
+}
+
+type AdjustmentSplit int32
+
+const (
+	// По умолчанию - ADJUSTMENT_SPLIT_PROPORTIONAL.
+	AdjustmentSplit_ADJUSTMENT_SPLIT_UNSPECIFIED AdjustmentSplit = 0
+	// Пропорционально тому, сколько каждый должен за позиции.
+	AdjustmentSplit_ADJUSTMENT_SPLIT_PROPORTIONAL AdjustmentSplit = 1
+	// Поровну между user_ids.
+	AdjustmentSplit_ADJUSTMENT_SPLIT_EQUAL AdjustmentSplit = 2
+)
+
+// Enum value maps for AdjustmentSplit.
+var (
+	AdjustmentSplit_name = map[int32]string{
+		0: "ADJUSTMENT_SPLIT_UNSPECIFIED",
+		1: "ADJUSTMENT_SPLIT_PROPORTIONAL",
+		2: "ADJUSTMENT_SPLIT_EQUAL",
+	}
+	AdjustmentSplit_value = map[string]int32{
+		"ADJUSTMENT_SPLIT_UNSPECIFIED":  0,
+		"ADJUSTMENT_SPLIT_PROPORTIONAL": 1,
+		"ADJUSTMENT_SPLIT_EQUAL":        2,
+	}
+)
+
+func (x AdjustmentSplit) Enum() *AdjustmentSplit {
+	p := new(AdjustmentSplit)
+	*p = x
+	return p
+}
+
+func (x AdjustmentSplit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdjustmentSplit) Descriptor() protoreflect.EnumDescriptor {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[3].Descriptor()
+}
+
+func (AdjustmentSplit) Type() protoreflect.EnumType {
+	return &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes[3]
+}
+
+func (x AdjustmentSplit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdjustmentSplit.Descriptor instead.
+func (AdjustmentSplit) EnumDescriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{3}
+}
+
 type BillShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Share  uint64 `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Только для SHARE_MODE_WEIGHT.
+	Share uint64    `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
+	Mode  ShareMode `protobuf:"varint,3,opt,name=mode,proto3,enum=dolgovnya.split_the_bill.v1.ShareMode" json:"mode,omitempty"`
+	// Только для SHARE_MODE_AMOUNT.
+	Amount *money.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Только для SHARE_MODE_PERCENT.
+	Percent *decimal.Decimal `protobuf:"bytes,5,opt,name=percent,proto3" json:"percent,omitempty"`
 }
 
 func (x *BillShare) Reset() {
@@ -77,16 +315,38 @@ func (x *BillShare) GetShare() uint64 {
 	return 0
 }
 
+func (x *BillShare) GetMode() ShareMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ShareMode_SHARE_MODE_UNSPECIFIED
+}
+
+func (x *BillShare) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *BillShare) GetPercent() *decimal.Decimal {
+	if x != nil {
+		return x.Percent
+	}
+	return nil
+}
+
 type BillItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	PricePerOne *money.Money     `protobuf:"bytes,2,opt,name=price_per_one,json=pricePerOne,proto3" json:"price_per_one,omitempty"`
-	Quantity    *decimal.Decimal `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Type        int64            `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
-	Shares      []*BillShare     `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PricePerOne *money.Money `protobuf:"bytes,2,opt,name=price_per_one,json=pricePerOne,proto3" json:"price_per_one,omitempty"`
+	// Может быть дробным, цена позиции округляется до минимальной единицы валюты.
+	Quantity *decimal.Decimal `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Type     int64            `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	Shares   []*BillShare     `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
 func (x *BillItem) Reset() {
@@ -156,17 +416,27 @@ func (x *BillItem) GetShares() []*BillShare {
 	return nil
 }
 
-type BillPayment struct {
+// Надбавка или скидка на весь счёт.
+type BillAdjustment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Title string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Kind  AdjustmentKind `protobuf:"varint,2,opt,name=kind,proto3,enum=dolgovnya.split_the_bill.v1.AdjustmentKind" json:"kind,omitempty"`
+	// Значение всегда положительное, скидку задаёт kind.
+	//
+	// Types that are assignable to Value:
+	//	*BillAdjustment_Percent
+	//	*BillAdjustment_Amount
+	Value isBillAdjustment_Value `protobuf_oneof:"value"`
+	Split AdjustmentSplit        `protobuf:"varint,5,opt,name=split,proto3,enum=dolgovnya.split_the_bill.v1.AdjustmentSplit" json:"split,omitempty"`
+	// Только для ADJUSTMENT_SPLIT_EQUAL.
+	UserIds []int64 `protobuf:"varint,6,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 }
 
-func (x *BillPayment) Reset() {
-	*x = BillPayment{}
+func (x *BillAdjustment) Reset() {
+	*x = BillAdjustment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -174,13 +444,13 @@ func (x *BillPayment) Reset() {
 	}
 }
 
-func (x *BillPayment) String() string {
+func (x *BillAdjustment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BillPayment) ProtoMessage() {}
+func (*BillAdjustment) ProtoMessage() {}
 
-func (x *BillPayment) ProtoReflect() protoreflect.Message {
+func (x *BillAdjustment) ProtoReflect() protoreflect.Message {
 	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,36 +462,88 @@ func (x *BillPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BillPayment.ProtoReflect.Descriptor instead.
-func (*BillPayment) Descriptor() ([]byte, []int) {
+// Deprecated: Use BillAdjustment.ProtoReflect.Descriptor instead.
+func (*BillAdjustment) Descriptor() ([]byte, []int) {
 	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BillPayment) GetUserId() int64 {
+func (x *BillAdjustment) GetTitle() string {
 	if x != nil {
-		return x.UserId
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *BillPayment) GetAmount() int64 {
+func (x *BillAdjustment) GetKind() AdjustmentKind {
 	if x != nil {
+		return x.Kind
+	}
+	return AdjustmentKind_ADJUSTMENT_KIND_UNSPECIFIED
+}
+
+func (m *BillAdjustment) GetValue() isBillAdjustment_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *BillAdjustment) GetPercent() *decimal.Decimal {
+	if x, ok := x.GetValue().(*BillAdjustment_Percent); ok {
+		return x.Percent
+	}
+	return nil
+}
+
+func (x *BillAdjustment) GetAmount() *money.Money {
+	if x, ok := x.GetValue().(*BillAdjustment_Amount); ok {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-type NewBillRequest struct {
+func (x *BillAdjustment) GetSplit() AdjustmentSplit {
+	if x != nil {
+		return x.Split
+	}
+	return AdjustmentSplit_ADJUSTMENT_SPLIT_UNSPECIFIED
+}
+
+func (x *BillAdjustment) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type isBillAdjustment_Value interface {
+	isBillAdjustment_Value()
+}
+
+type BillAdjustment_Percent struct {
+	// Процент от суммы позиций, округляется до минимальной единицы валюты.
+	Percent *decimal.Decimal `protobuf:"bytes,3,opt,name=percent,proto3,oneof"`
+}
+
+type BillAdjustment_Amount struct {
+	Amount *money.Money `protobuf:"bytes,4,opt,name=amount,proto3,oneof"`
+}
+
+func (*BillAdjustment_Percent) isBillAdjustment_Value() {}
+
+func (*BillAdjustment_Amount) isBillAdjustment_Value() {}
+
+type BillPayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items    []*BillItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Payments []*BillPayment `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments,omitempty"`
+	UserId int64        `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *NewBillRequest) Reset() {
-	*x = NewBillRequest{}
+func (x *BillPayment) Reset() {
+	*x = BillPayment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -229,13 +551,13 @@ func (x *NewBillRequest) Reset() {
 	}
 }
 
-func (x *NewBillRequest) String() string {
+func (x *BillPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewBillRequest) ProtoMessage() {}
+func (*BillPayment) ProtoMessage() {}
 
-func (x *NewBillRequest) ProtoReflect() protoreflect.Message {
+func (x *BillPayment) ProtoReflect() protoreflect.Message {
 	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,35 +569,38 @@ func (x *NewBillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewBillRequest.ProtoReflect.Descriptor instead.
-func (*NewBillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BillPayment.ProtoReflect.Descriptor instead.
+func (*BillPayment) Descriptor() ([]byte, []int) {
 	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *NewBillRequest) GetItems() []*BillItem {
+func (x *BillPayment) GetUserId() int64 {
 	if x != nil {
-		return x.Items
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *NewBillRequest) GetPayments() []*BillPayment {
+func (x *BillPayment) GetAmount() *money.Money {
 	if x != nil {
-		return x.Payments
+		return x.Amount
 	}
 	return nil
 }
 
-type NewBillResponse struct {
+// Кто кому сколько должен по счёту.
+type Invoice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BillId uint64 `protobuf:"varint,1,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+	UserFrom int64        `protobuf:"varint,1,opt,name=user_from,json=userFrom,proto3" json:"user_from,omitempty"`
+	UserTo   int64        `protobuf:"varint,2,opt,name=user_to,json=userTo,proto3" json:"user_to,omitempty"`
+	Amount   *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *NewBillResponse) Reset() {
-	*x = NewBillResponse{}
+func (x *Invoice) Reset() {
+	*x = Invoice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -283,13 +608,13 @@ func (x *NewBillResponse) Reset() {
 	}
 }
 
-func (x *NewBillResponse) String() string {
+func (x *Invoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewBillResponse) ProtoMessage() {}
+func (*Invoice) ProtoMessage() {}
 
-func (x *NewBillResponse) ProtoReflect() protoreflect.Message {
+func (x *Invoice) ProtoReflect() protoreflect.Message {
 	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,153 +626,1832 @@ func (x *NewBillResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewBillResponse.ProtoReflect.Descriptor instead.
-func (*NewBillResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
 	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *NewBillResponse) GetBillId() uint64 {
+func (x *Invoice) GetUserFrom() int64 {
 	if x != nil {
-		return x.BillId
+		return x.UserFrom
 	}
 	return 0
 }
 
-var File_dolgovnya_split_the_bill_v1_split_the_bill_proto protoreflect.FileDescriptor
+func (x *Invoice) GetUserTo() int64 {
+	if x != nil {
+		return x.UserTo
+	}
+	return 0
+}
 
-var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDesc = []byte{
-	0x0a, 0x30, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x73, 0x70, 0x6c, 0x69,
-	0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70,
-	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x1b, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
-	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
-	0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63,
-	0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x3a, 0x0a, 0x09, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22,
-	0xde, 0x01, 0x0a, 0x08, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x4f, 0x6e, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x3e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c,
-	0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
-	0x22, 0x3e, 0x0a, 0x0b, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x93, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73,
-	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x44, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73,
-	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6c,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x69, 0x6c, 0x6c,
-	0x49, 0x64, 0x32, 0x7b, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69,
-	0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x07, 0x4e, 0x65, 0x77,
-	0x42, 0x69, 0x6c, 0x6c, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
-	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
-	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x99, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
-	0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c,
-	0x2e, 0x76, 0x31, 0x42, 0x11, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c,
-	0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x6c, 0x61, 0x6d, 0x4a, 0x61, 0x6d, 0x2f, 0x64, 0x6f, 0x6c,
-	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f,
-	0x76, 0x6e, 0x79, 0x61, 0x2f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62,
-	0x69, 0x6c, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65,
-	0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x44, 0x53, 0x58, 0xaa, 0x02, 0x19,
-	0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
-	0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x44, 0x6f, 0x6c, 0x67,
-	0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69,
-	0x6c, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
-	0x61, 0x5c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x3a, 0x3a, 0x53, 0x70, 0x6c, 0x69, 0x74,
-	0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *Invoice) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
 }
 
-var (
-	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescOnce sync.Once
-	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData = file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDesc
-)
+type Bill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP() []byte {
-	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescOnce.Do(func() {
-		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData = protoimpl.X.CompressGZIP(file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData)
-	})
-	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId   int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Items     []*BillItem            `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Payments  []*BillPayment         `protobuf:"bytes,5,rep,name=payments,proto3" json:"payments,omitempty"`
+	// Вычисляются из items и payments, не хранятся.
+	Invoices []*Invoice `protobuf:"bytes,6,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	// Код ISO 4217, все суммы счёта в этой валюте.
+	CurrencyCode string            `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Rounding     RoundingStrategy  `protobuf:"varint,8,opt,name=rounding,proto3,enum=dolgovnya.split_the_bill.v1.RoundingStrategy" json:"rounding,omitempty"`
+	Adjustments  []*BillAdjustment `protobuf:"bytes,9,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	// Сколько знаков после запятой допустимо в quantity позиций.
+	QuantityPrecision int32 `protobuf:"varint,10,opt,name=quantity_precision,json=quantityPrecision,proto3" json:"quantity_precision,omitempty"`
+	// Растёт на 1 при каждом изменении счёта, у нового счёта - 1.
+	Revision uint32 `protobuf:"varint,11,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Группа, к которой относится счёт. 0 - личный счёт.
+	GroupId int64 `protobuf:"varint,12,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_goTypes = []interface{}{
-	(*BillShare)(nil),       // 0: dolgovnya.split_the_bill.v1.BillShare
-	(*BillItem)(nil),        // 1: dolgovnya.split_the_bill.v1.BillItem
-	(*BillPayment)(nil),     // 2: dolgovnya.split_the_bill.v1.BillPayment
-	(*NewBillRequest)(nil),  // 3: dolgovnya.split_the_bill.v1.NewBillRequest
-	(*NewBillResponse)(nil), // 4: dolgovnya.split_the_bill.v1.NewBillResponse
-	(*money.Money)(nil),     // 5: google.type.Money
-	(*decimal.Decimal)(nil), // 6: google.type.Decimal
+func (x *Bill) Reset() {
+	*x = Bill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_depIdxs = []int32{
-	5, // 0: dolgovnya.split_the_bill.v1.BillItem.price_per_one:type_name -> google.type.Money
-	6, // 1: dolgovnya.split_the_bill.v1.BillItem.quantity:type_name -> google.type.Decimal
-	0, // 2: dolgovnya.split_the_bill.v1.BillItem.shares:type_name -> dolgovnya.split_the_bill.v1.BillShare
-	1, // 3: dolgovnya.split_the_bill.v1.NewBillRequest.items:type_name -> dolgovnya.split_the_bill.v1.BillItem
-	2, // 4: dolgovnya.split_the_bill.v1.NewBillRequest.payments:type_name -> dolgovnya.split_the_bill.v1.BillPayment
-	3, // 5: dolgovnya.split_the_bill.v1.SplitTheBillService.NewBill:input_type -> dolgovnya.split_the_bill.v1.NewBillRequest
-	4, // 6: dolgovnya.split_the_bill.v1.SplitTheBillService.NewBill:output_type -> dolgovnya.split_the_bill.v1.NewBillResponse
-	6, // [6:7] is the sub-list for method output_type
-	5, // [5:6] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+
+func (x *Bill) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() }
-func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() {
-	if File_dolgovnya_split_the_bill_v1_split_the_bill_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillShare); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*Bill) ProtoMessage() {}
+
+func (x *Bill) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillItem); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bill.ProtoReflect.Descriptor instead.
+func (*Bill) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Bill) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Bill) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Bill) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Bill) GetItems() []*BillItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Bill) GetPayments() []*BillPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *Bill) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *Bill) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Bill) GetRounding() RoundingStrategy {
+	if x != nil {
+		return x.Rounding
+	}
+	return RoundingStrategy_ROUNDING_STRATEGY_UNSPECIFIED
+}
+
+func (x *Bill) GetAdjustments() []*BillAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *Bill) GetQuantityPrecision() int32 {
+	if x != nil {
+		return x.QuantityPrecision
+	}
+	return 0
+}
+
+func (x *Bill) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Bill) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type NewBillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items    []*BillItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Payments []*BillPayment `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments,omitempty"`
+	// Код ISO 4217. Если пустой, то RUB. Суммы в items и payments должны быть
+	// в этой валюте или без currency_code.
+	CurrencyCode string            `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Rounding     RoundingStrategy  `protobuf:"varint,4,opt,name=rounding,proto3,enum=dolgovnya.split_the_bill.v1.RoundingStrategy" json:"rounding,omitempty"`
+	Adjustments  []*BillAdjustment `protobuf:"bytes,5,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	// Сколько знаков после запятой допустимо в quantity позиций. Если 0, то 3.
+	QuantityPrecision int32 `protobuf:"varint,6,opt,name=quantity_precision,json=quantityPrecision,proto3" json:"quantity_precision,omitempty"`
+	// Счёт группы: все участники счёта должны быть в группе. Долги по нему
+	// попадают в балансы группы.
+	GroupId int64 `protobuf:"varint,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *NewBillRequest) Reset() {
+	*x = NewBillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewBillRequest) ProtoMessage() {}
+
+func (x *NewBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewBillRequest.ProtoReflect.Descriptor instead.
+func (*NewBillRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NewBillRequest) GetItems() []*BillItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *NewBillRequest) GetPayments() []*BillPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *NewBillRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *NewBillRequest) GetRounding() RoundingStrategy {
+	if x != nil {
+		return x.Rounding
+	}
+	return RoundingStrategy_ROUNDING_STRATEGY_UNSPECIFIED
+}
+
+func (x *NewBillRequest) GetAdjustments() []*BillAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *NewBillRequest) GetQuantityPrecision() int32 {
+	if x != nil {
+		return x.QuantityPrecision
+	}
+	return 0
+}
+
+func (x *NewBillRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type NewBillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillId uint64 `protobuf:"varint,1,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+}
+
+func (x *NewBillResponse) Reset() {
+	*x = NewBillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewBillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewBillResponse) ProtoMessage() {}
+
+func (x *NewBillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewBillResponse.ProtoReflect.Descriptor instead.
+func (*NewBillResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NewBillResponse) GetBillId() uint64 {
+	if x != nil {
+		return x.BillId
+	}
+	return 0
+}
+
+type GetBillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillId uint64 `protobuf:"varint,1,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+}
+
+func (x *GetBillRequest) Reset() {
+	*x = GetBillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillRequest) ProtoMessage() {}
+
+func (x *GetBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillRequest.ProtoReflect.Descriptor instead.
+func (*GetBillRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBillRequest) GetBillId() uint64 {
+	if x != nil {
+		return x.BillId
+	}
+	return 0
+}
+
+type GetBillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bill *Bill `protobuf:"bytes,1,opt,name=bill,proto3" json:"bill,omitempty"`
+}
+
+func (x *GetBillResponse) Reset() {
+	*x = GetBillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillResponse) ProtoMessage() {}
+
+func (x *GetBillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillResponse.ProtoReflect.Descriptor instead.
+func (*GetBillResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBillResponse) GetBill() *Bill {
+	if x != nil {
+		return x.Bill
+	}
+	return nil
+}
+
+type ListBillsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Если 0, то размер страницы по умолчанию.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token из предыдущего ответа.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Только счета, в которых участвует пользователь.
+	ParticipantId int64 `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// Полуинтервал [created_from, created_to).
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// Все счета группы. Смотреть их может любой участник группы.
+	GroupId int64 `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *ListBillsRequest) Reset() {
+	*x = ListBillsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillsRequest) ProtoMessage() {}
+
+func (x *ListBillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillsRequest.ProtoReflect.Descriptor instead.
+func (*ListBillsRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBillsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBillsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBillsRequest) GetParticipantId() int64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+func (x *ListBillsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListBillsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListBillsRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListBillsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bills []*Bill `protobuf:"bytes,1,rep,name=bills,proto3" json:"bills,omitempty"`
+	// Пустой, если страниц больше нет.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBillsResponse) Reset() {
+	*x = ListBillsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillsResponse) ProtoMessage() {}
+
+func (x *ListBillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillsResponse.ProtoReflect.Descriptor instead.
+func (*ListBillsResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBillsResponse) GetBills() []*Bill {
+	if x != nil {
+		return x.Bills
+	}
+	return nil
+}
+
+func (x *ListBillsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteBillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillId uint64 `protobuf:"varint,1,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+}
+
+func (x *DeleteBillRequest) Reset() {
+	*x = DeleteBillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBillRequest) ProtoMessage() {}
+
+func (x *DeleteBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBillRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBillRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteBillRequest) GetBillId() uint64 {
+	if x != nil {
+		return x.BillId
+	}
+	return 0
+}
+
+type DeleteBillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Удалённый счёт.
+	Bill *Bill `protobuf:"bytes,1,opt,name=bill,proto3" json:"bill,omitempty"`
+}
+
+func (x *DeleteBillResponse) Reset() {
+	*x = DeleteBillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBillResponse) ProtoMessage() {}
+
+func (x *DeleteBillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBillResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBillResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteBillResponse) GetBill() *Bill {
+	if x != nil {
+		return x.Bill
+	}
+	return nil
+}
+
+// Новое содержимое счёта целиком. Владелец и время создания не меняются.
+type UpdateBillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillId uint64 `protobuf:"varint,1,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+	// Ревизия, которую редактировали. Если счёт успели изменить, то ABORTED.
+	Revision          uint32            `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Items             []*BillItem       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Payments          []*BillPayment    `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty"`
+	CurrencyCode      string            `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Rounding          RoundingStrategy  `protobuf:"varint,6,opt,name=rounding,proto3,enum=dolgovnya.split_the_bill.v1.RoundingStrategy" json:"rounding,omitempty"`
+	Adjustments       []*BillAdjustment `protobuf:"bytes,7,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	QuantityPrecision int32             `protobuf:"varint,8,opt,name=quantity_precision,json=quantityPrecision,proto3" json:"quantity_precision,omitempty"`
+}
+
+func (x *UpdateBillRequest) Reset() {
+	*x = UpdateBillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBillRequest) ProtoMessage() {}
+
+func (x *UpdateBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBillRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBillRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateBillRequest) GetBillId() uint64 {
+	if x != nil {
+		return x.BillId
+	}
+	return 0
+}
+
+func (x *UpdateBillRequest) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *UpdateBillRequest) GetItems() []*BillItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateBillRequest) GetPayments() []*BillPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *UpdateBillRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *UpdateBillRequest) GetRounding() RoundingStrategy {
+	if x != nil {
+		return x.Rounding
+	}
+	return RoundingStrategy_ROUNDING_STRATEGY_UNSPECIFIED
+}
+
+func (x *UpdateBillRequest) GetAdjustments() []*BillAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *UpdateBillRequest) GetQuantityPrecision() int32 {
+	if x != nil {
+		return x.QuantityPrecision
+	}
+	return 0
+}
+
+type UpdateBillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Счёт с новой ревизией.
+	Bill *Bill `protobuf:"bytes,1,opt,name=bill,proto3" json:"bill,omitempty"`
+}
+
+func (x *UpdateBillResponse) Reset() {
+	*x = UpdateBillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBillResponse) ProtoMessage() {}
+
+func (x *UpdateBillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBillResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBillResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateBillResponse) GetBill() *Bill {
+	if x != nil {
+		return x.Bill
+	}
+	return nil
+}
+
+type GetBillHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillId uint64 `protobuf:"varint,1,opt,name=bill_id,json=billId,proto3" json:"bill_id,omitempty"`
+}
+
+func (x *GetBillHistoryRequest) Reset() {
+	*x = GetBillHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillHistoryRequest) ProtoMessage() {}
+
+func (x *GetBillHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBillHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBillHistoryRequest) GetBillId() uint64 {
+	if x != nil {
+		return x.BillId
+	}
+	return 0
+}
+
+// Доля пользователя в позиции до и после. Пустая - доли не было.
+type ShareChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64      `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Old    *BillShare `protobuf:"bytes,2,opt,name=old,proto3" json:"old,omitempty"`
+	New    *BillShare `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+}
+
+func (x *ShareChange) Reset() {
+	*x = ShareChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareChange) ProtoMessage() {}
+
+func (x *ShareChange) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareChange.ProtoReflect.Descriptor instead.
+func (*ShareChange) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShareChange) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShareChange) GetOld() *BillShare {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *ShareChange) GetNew() *BillShare {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+// Позиция, которая есть в обеих версиях. Позиции сопоставляются по
+// названию, позиции с одинаковым названием - по порядку.
+type BillItemChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title           string    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Old             *BillItem `protobuf:"bytes,2,opt,name=old,proto3" json:"old,omitempty"`
+	New             *BillItem `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+	PriceChanged    bool      `protobuf:"varint,4,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"`
+	QuantityChanged bool      `protobuf:"varint,5,opt,name=quantity_changed,json=quantityChanged,proto3" json:"quantity_changed,omitempty"`
+	// Только изменившиеся доли, по возрастанию user_id.
+	ShareChanges []*ShareChange `protobuf:"bytes,6,rep,name=share_changes,json=shareChanges,proto3" json:"share_changes,omitempty"`
+}
+
+func (x *BillItemChange) Reset() {
+	*x = BillItemChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillItemChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillItemChange) ProtoMessage() {}
+
+func (x *BillItemChange) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillItemChange.ProtoReflect.Descriptor instead.
+func (*BillItemChange) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BillItemChange) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BillItemChange) GetOld() *BillItem {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *BillItemChange) GetNew() *BillItem {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+func (x *BillItemChange) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *BillItemChange) GetQuantityChanged() bool {
+	if x != nil {
+		return x.QuantityChanged
+	}
+	return false
+}
+
+func (x *BillItemChange) GetShareChanges() []*ShareChange {
+	if x != nil {
+		return x.ShareChanges
+	}
+	return nil
+}
+
+// Баланс пользователя по счёту: положительный - ему должны, отрицательный -
+// должен он. old - в валюте предыдущей версии, new - в валюте новой.
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64        `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Old    *money.Money `protobuf:"bytes,2,opt,name=old,proto3" json:"old,omitempty"`
+	New    *money.Money `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+	// Не задана, если у версий разные валюты: суммы не сравнимы.
+	Delta           *money.Money `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	CurrencyChanged bool         `protobuf:"varint,5,opt,name=currency_changed,json=currencyChanged,proto3" json:"currency_changed,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BalanceChange) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetOld() *money.Money {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetNew() *money.Money {
+	if x != nil {
+		return x.New
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetDelta() *money.Money {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetCurrencyChanged() bool {
+	if x != nil {
+		return x.CurrencyChanged
+	}
+	return false
+}
+
+type BillDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemsAdded   []*BillItem       `protobuf:"bytes,1,rep,name=items_added,json=itemsAdded,proto3" json:"items_added,omitempty"`
+	ItemsRemoved []*BillItem       `protobuf:"bytes,2,rep,name=items_removed,json=itemsRemoved,proto3" json:"items_removed,omitempty"`
+	ItemsChanged []*BillItemChange `protobuf:"bytes,3,rep,name=items_changed,json=itemsChanged,proto3" json:"items_changed,omitempty"`
+	// Только пользователи, у которых баланс изменился. При смене валюты -
+	// все, у кого был или стал ненулевой баланс.
+	BalanceChanges []*BalanceChange `protobuf:"bytes,4,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
+}
+
+func (x *BillDiff) Reset() {
+	*x = BillDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillDiff) ProtoMessage() {}
+
+func (x *BillDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillDiff.ProtoReflect.Descriptor instead.
+func (*BillDiff) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BillDiff) GetItemsAdded() []*BillItem {
+	if x != nil {
+		return x.ItemsAdded
+	}
+	return nil
+}
+
+func (x *BillDiff) GetItemsRemoved() []*BillItem {
+	if x != nil {
+		return x.ItemsRemoved
+	}
+	return nil
+}
+
+func (x *BillDiff) GetItemsChanged() []*BillItemChange {
+	if x != nil {
+		return x.ItemsChanged
+	}
+	return nil
+}
+
+func (x *BillDiff) GetBalanceChanges() []*BalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+type BillRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint32 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Кто сохранил эту версию.
+	EditorId  int64                  `protobuf:"varint,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Bill      *Bill                  `protobuf:"bytes,4,opt,name=bill,proto3" json:"bill,omitempty"`
+	// Отличия от предыдущей версии, у первой - от пустого счёта.
+	Diff *BillDiff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *BillRevision) Reset() {
+	*x = BillRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillRevision) ProtoMessage() {}
+
+func (x *BillRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillRevision.ProtoReflect.Descriptor instead.
+func (*BillRevision) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BillRevision) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *BillRevision) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *BillRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BillRevision) GetBill() *Bill {
+	if x != nil {
+		return x.Bill
+	}
+	return nil
+}
+
+func (x *BillRevision) GetDiff() *BillDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type GetBillHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// По возрастанию revision.
+	Revisions []*BillRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetBillHistoryResponse) Reset() {
+	*x = GetBillHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillHistoryResponse) ProtoMessage() {}
+
+func (x *GetBillHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBillHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBillHistoryResponse) GetRevisions() []*BillRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+var File_dolgovnya_split_the_bill_v1_split_the_bill_proto protoreflect.FileDescriptor
+
+var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1b, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x09, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x08, 0x42, 0x69,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x4f, 0x6e, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65,
+	0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x0e, 0x42,
+	0x69, 0x6c, 0x6c, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x58, 0x0a, 0x0b,
+	0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x6b, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xd6, 0x04, 0x0a, 0x04, 0x42, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x44, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
+	0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69,
+	0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64,
+	0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74,
+	0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x41,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x9c, 0x03, 0x0a,
+	0x0e, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x64, 0x6f, 0x6c, 0x67,
+	0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f,
+	0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
+	0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69,
+	0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0f, 0x4e,
+	0x65, 0x77, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x69, 0x6c, 0x6c,
+	0x49, 0x64, 0x22, 0x48, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x22, 0x8a, 0x02, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x05, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c,
+	0x52, 0x05, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x4b, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x52, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x22, 0xb9, 0x03, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x49, 0x0a,
+	0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0b, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04,
+	0x62, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65,
+	0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x04, 0x62,
+	0x69, 0x6c, 0x6c, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62,
+	0x69, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x6f,
+	0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68,
+	0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
+	0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x03, 0x6e,
+	0x65, 0x77, 0x22, 0xb7, 0x02, 0x0a, 0x0e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x03, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f,
+	0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62,
+	0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x03, 0x6f, 0x6c, 0x64, 0x12, 0x37, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x4d, 0x0a,
+	0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xc9, 0x01, 0x0a,
+	0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x24, 0x0a,
+	0x03, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03,
+	0x6e, 0x65, 0x77, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0xc5, 0x02, 0x0a, 0x08, 0x42, 0x69, 0x6c,
+	0x6c, 0x44, 0x69, 0x66, 0x66, 0x12, 0x46, 0x0a, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65,
+	0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x4a, 0x0a,
+	0x0d, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x0d, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x12, 0x39, 0x0a, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6c,
+	0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65,
+	0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0xcb, 0x01, 0x0a, 0x10, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x21, 0x0a, 0x1d, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41,
+	0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x53, 0x54, 0x5f,
+	0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x50, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x41, 0x42, 0x53, 0x4f, 0x52, 0x42, 0x53, 0x10, 0x02,
+	0x12, 0x23, 0x0a, 0x1f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x41, 0x42, 0x53, 0x4f,
+	0x52, 0x42, 0x53, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44,
+	0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x04, 0x2a, 0x87, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x48, 0x41,
+	0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x48, 0x41, 0x52,
+	0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44, 0x45, 0x52,
+	0x10, 0x04, 0x2a, 0xa5, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x54, 0x41, 0x58, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x44, 0x4a, 0x55,
+	0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x2a, 0x72, 0x0a, 0x0f, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x20, 0x0a,
+	0x1c, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x4c, 0x49,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x21, 0x0a, 0x1d, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x50,
+	0x4c, 0x49, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x32, 0xa6,
+	0x05, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c,
+	0x6c, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x65, 0x77, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77,
+	0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76,
+	0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69,
+	0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61,
+	0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x12,
+	0x2d, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x64,
+	0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74,
+	0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64,
+	0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74,
+	0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x64, 0x6f,
+	0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68,
+	0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x6f,
+	0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68,
+	0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x32,
+	0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x99, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x6c, 0x61,
+	0x6d, 0x4a, 0x61, 0x6d, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2d, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x2f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x44, 0x53, 0x58, 0xaa, 0x02, 0x19, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
+	0x61, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x19, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25,
+	0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79, 0x61, 0x5c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
+	0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x44, 0x6f, 0x6c, 0x67, 0x6f, 0x76, 0x6e, 0x79,
+	0x61, 0x3a, 0x3a, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x68, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescOnce sync.Once
+	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData = file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDesc
+)
+
+func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescGZIP() []byte {
+	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescOnce.Do(func() {
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData = protoimpl.X.CompressGZIP(file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData)
+	})
+	return file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDescData
+}
+
+var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_goTypes = []interface{}{
+	(RoundingStrategy)(0),          // 0: dolgovnya.split_the_bill.v1.RoundingStrategy
+	(ShareMode)(0),                 // 1: dolgovnya.split_the_bill.v1.ShareMode
+	(AdjustmentKind)(0),            // 2: dolgovnya.split_the_bill.v1.AdjustmentKind
+	(AdjustmentSplit)(0),           // 3: dolgovnya.split_the_bill.v1.AdjustmentSplit
+	(*BillShare)(nil),              // 4: dolgovnya.split_the_bill.v1.BillShare
+	(*BillItem)(nil),               // 5: dolgovnya.split_the_bill.v1.BillItem
+	(*BillAdjustment)(nil),         // 6: dolgovnya.split_the_bill.v1.BillAdjustment
+	(*BillPayment)(nil),            // 7: dolgovnya.split_the_bill.v1.BillPayment
+	(*Invoice)(nil),                // 8: dolgovnya.split_the_bill.v1.Invoice
+	(*Bill)(nil),                   // 9: dolgovnya.split_the_bill.v1.Bill
+	(*NewBillRequest)(nil),         // 10: dolgovnya.split_the_bill.v1.NewBillRequest
+	(*NewBillResponse)(nil),        // 11: dolgovnya.split_the_bill.v1.NewBillResponse
+	(*GetBillRequest)(nil),         // 12: dolgovnya.split_the_bill.v1.GetBillRequest
+	(*GetBillResponse)(nil),        // 13: dolgovnya.split_the_bill.v1.GetBillResponse
+	(*ListBillsRequest)(nil),       // 14: dolgovnya.split_the_bill.v1.ListBillsRequest
+	(*ListBillsResponse)(nil),      // 15: dolgovnya.split_the_bill.v1.ListBillsResponse
+	(*DeleteBillRequest)(nil),      // 16: dolgovnya.split_the_bill.v1.DeleteBillRequest
+	(*DeleteBillResponse)(nil),     // 17: dolgovnya.split_the_bill.v1.DeleteBillResponse
+	(*UpdateBillRequest)(nil),      // 18: dolgovnya.split_the_bill.v1.UpdateBillRequest
+	(*UpdateBillResponse)(nil),     // 19: dolgovnya.split_the_bill.v1.UpdateBillResponse
+	(*GetBillHistoryRequest)(nil),  // 20: dolgovnya.split_the_bill.v1.GetBillHistoryRequest
+	(*ShareChange)(nil),            // 21: dolgovnya.split_the_bill.v1.ShareChange
+	(*BillItemChange)(nil),         // 22: dolgovnya.split_the_bill.v1.BillItemChange
+	(*BalanceChange)(nil),          // 23: dolgovnya.split_the_bill.v1.BalanceChange
+	(*BillDiff)(nil),               // 24: dolgovnya.split_the_bill.v1.BillDiff
+	(*BillRevision)(nil),           // 25: dolgovnya.split_the_bill.v1.BillRevision
+	(*GetBillHistoryResponse)(nil), // 26: dolgovnya.split_the_bill.v1.GetBillHistoryResponse
+	(*money.Money)(nil),            // 27: google.type.Money
+	(*decimal.Decimal)(nil),        // 28: google.type.Decimal
+	(*timestamppb.Timestamp)(nil),  // 29: google.protobuf.Timestamp
+}
+var file_dolgovnya_split_the_bill_v1_split_the_bill_proto_depIdxs = []int32{
+	1,  // 0: dolgovnya.split_the_bill.v1.BillShare.mode:type_name -> dolgovnya.split_the_bill.v1.ShareMode
+	27, // 1: dolgovnya.split_the_bill.v1.BillShare.amount:type_name -> google.type.Money
+	28, // 2: dolgovnya.split_the_bill.v1.BillShare.percent:type_name -> google.type.Decimal
+	27, // 3: dolgovnya.split_the_bill.v1.BillItem.price_per_one:type_name -> google.type.Money
+	28, // 4: dolgovnya.split_the_bill.v1.BillItem.quantity:type_name -> google.type.Decimal
+	4,  // 5: dolgovnya.split_the_bill.v1.BillItem.shares:type_name -> dolgovnya.split_the_bill.v1.BillShare
+	2,  // 6: dolgovnya.split_the_bill.v1.BillAdjustment.kind:type_name -> dolgovnya.split_the_bill.v1.AdjustmentKind
+	28, // 7: dolgovnya.split_the_bill.v1.BillAdjustment.percent:type_name -> google.type.Decimal
+	27, // 8: dolgovnya.split_the_bill.v1.BillAdjustment.amount:type_name -> google.type.Money
+	3,  // 9: dolgovnya.split_the_bill.v1.BillAdjustment.split:type_name -> dolgovnya.split_the_bill.v1.AdjustmentSplit
+	27, // 10: dolgovnya.split_the_bill.v1.BillPayment.amount:type_name -> google.type.Money
+	27, // 11: dolgovnya.split_the_bill.v1.Invoice.amount:type_name -> google.type.Money
+	29, // 12: dolgovnya.split_the_bill.v1.Bill.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 13: dolgovnya.split_the_bill.v1.Bill.items:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	7,  // 14: dolgovnya.split_the_bill.v1.Bill.payments:type_name -> dolgovnya.split_the_bill.v1.BillPayment
+	8,  // 15: dolgovnya.split_the_bill.v1.Bill.invoices:type_name -> dolgovnya.split_the_bill.v1.Invoice
+	0,  // 16: dolgovnya.split_the_bill.v1.Bill.rounding:type_name -> dolgovnya.split_the_bill.v1.RoundingStrategy
+	6,  // 17: dolgovnya.split_the_bill.v1.Bill.adjustments:type_name -> dolgovnya.split_the_bill.v1.BillAdjustment
+	5,  // 18: dolgovnya.split_the_bill.v1.NewBillRequest.items:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	7,  // 19: dolgovnya.split_the_bill.v1.NewBillRequest.payments:type_name -> dolgovnya.split_the_bill.v1.BillPayment
+	0,  // 20: dolgovnya.split_the_bill.v1.NewBillRequest.rounding:type_name -> dolgovnya.split_the_bill.v1.RoundingStrategy
+	6,  // 21: dolgovnya.split_the_bill.v1.NewBillRequest.adjustments:type_name -> dolgovnya.split_the_bill.v1.BillAdjustment
+	9,  // 22: dolgovnya.split_the_bill.v1.GetBillResponse.bill:type_name -> dolgovnya.split_the_bill.v1.Bill
+	29, // 23: dolgovnya.split_the_bill.v1.ListBillsRequest.created_from:type_name -> google.protobuf.Timestamp
+	29, // 24: dolgovnya.split_the_bill.v1.ListBillsRequest.created_to:type_name -> google.protobuf.Timestamp
+	9,  // 25: dolgovnya.split_the_bill.v1.ListBillsResponse.bills:type_name -> dolgovnya.split_the_bill.v1.Bill
+	9,  // 26: dolgovnya.split_the_bill.v1.DeleteBillResponse.bill:type_name -> dolgovnya.split_the_bill.v1.Bill
+	5,  // 27: dolgovnya.split_the_bill.v1.UpdateBillRequest.items:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	7,  // 28: dolgovnya.split_the_bill.v1.UpdateBillRequest.payments:type_name -> dolgovnya.split_the_bill.v1.BillPayment
+	0,  // 29: dolgovnya.split_the_bill.v1.UpdateBillRequest.rounding:type_name -> dolgovnya.split_the_bill.v1.RoundingStrategy
+	6,  // 30: dolgovnya.split_the_bill.v1.UpdateBillRequest.adjustments:type_name -> dolgovnya.split_the_bill.v1.BillAdjustment
+	9,  // 31: dolgovnya.split_the_bill.v1.UpdateBillResponse.bill:type_name -> dolgovnya.split_the_bill.v1.Bill
+	4,  // 32: dolgovnya.split_the_bill.v1.ShareChange.old:type_name -> dolgovnya.split_the_bill.v1.BillShare
+	4,  // 33: dolgovnya.split_the_bill.v1.ShareChange.new:type_name -> dolgovnya.split_the_bill.v1.BillShare
+	5,  // 34: dolgovnya.split_the_bill.v1.BillItemChange.old:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	5,  // 35: dolgovnya.split_the_bill.v1.BillItemChange.new:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	21, // 36: dolgovnya.split_the_bill.v1.BillItemChange.share_changes:type_name -> dolgovnya.split_the_bill.v1.ShareChange
+	27, // 37: dolgovnya.split_the_bill.v1.BalanceChange.old:type_name -> google.type.Money
+	27, // 38: dolgovnya.split_the_bill.v1.BalanceChange.new:type_name -> google.type.Money
+	27, // 39: dolgovnya.split_the_bill.v1.BalanceChange.delta:type_name -> google.type.Money
+	5,  // 40: dolgovnya.split_the_bill.v1.BillDiff.items_added:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	5,  // 41: dolgovnya.split_the_bill.v1.BillDiff.items_removed:type_name -> dolgovnya.split_the_bill.v1.BillItem
+	22, // 42: dolgovnya.split_the_bill.v1.BillDiff.items_changed:type_name -> dolgovnya.split_the_bill.v1.BillItemChange
+	23, // 43: dolgovnya.split_the_bill.v1.BillDiff.balance_changes:type_name -> dolgovnya.split_the_bill.v1.BalanceChange
+	29, // 44: dolgovnya.split_the_bill.v1.BillRevision.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 45: dolgovnya.split_the_bill.v1.BillRevision.bill:type_name -> dolgovnya.split_the_bill.v1.Bill
+	24, // 46: dolgovnya.split_the_bill.v1.BillRevision.diff:type_name -> dolgovnya.split_the_bill.v1.BillDiff
+	25, // 47: dolgovnya.split_the_bill.v1.GetBillHistoryResponse.revisions:type_name -> dolgovnya.split_the_bill.v1.BillRevision
+	10, // 48: dolgovnya.split_the_bill.v1.SplitTheBillService.NewBill:input_type -> dolgovnya.split_the_bill.v1.NewBillRequest
+	12, // 49: dolgovnya.split_the_bill.v1.SplitTheBillService.GetBill:input_type -> dolgovnya.split_the_bill.v1.GetBillRequest
+	14, // 50: dolgovnya.split_the_bill.v1.SplitTheBillService.ListBills:input_type -> dolgovnya.split_the_bill.v1.ListBillsRequest
+	16, // 51: dolgovnya.split_the_bill.v1.SplitTheBillService.DeleteBill:input_type -> dolgovnya.split_the_bill.v1.DeleteBillRequest
+	18, // 52: dolgovnya.split_the_bill.v1.SplitTheBillService.UpdateBill:input_type -> dolgovnya.split_the_bill.v1.UpdateBillRequest
+	20, // 53: dolgovnya.split_the_bill.v1.SplitTheBillService.GetBillHistory:input_type -> dolgovnya.split_the_bill.v1.GetBillHistoryRequest
+	11, // 54: dolgovnya.split_the_bill.v1.SplitTheBillService.NewBill:output_type -> dolgovnya.split_the_bill.v1.NewBillResponse
+	13, // 55: dolgovnya.split_the_bill.v1.SplitTheBillService.GetBill:output_type -> dolgovnya.split_the_bill.v1.GetBillResponse
+	15, // 56: dolgovnya.split_the_bill.v1.SplitTheBillService.ListBills:output_type -> dolgovnya.split_the_bill.v1.ListBillsResponse
+	17, // 57: dolgovnya.split_the_bill.v1.SplitTheBillService.DeleteBill:output_type -> dolgovnya.split_the_bill.v1.DeleteBillResponse
+	19, // 58: dolgovnya.split_the_bill.v1.SplitTheBillService.UpdateBill:output_type -> dolgovnya.split_the_bill.v1.UpdateBillResponse
+	26, // 59: dolgovnya.split_the_bill.v1.SplitTheBillService.GetBillHistory:output_type -> dolgovnya.split_the_bill.v1.GetBillHistoryResponse
+	54, // [54:60] is the sub-list for method output_type
+	48, // [48:54] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() }
+func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() {
+	if File_dolgovnya_split_the_bill_v1_split_the_bill_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
 			default:
@@ -455,7 +2459,7 @@ func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() {
 			}
 		}
 		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillPayment); i {
+			switch v := v.(*BillAdjustment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -467,7 +2471,7 @@ func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() {
 			}
 		}
 		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewBillRequest); i {
+			switch v := v.(*BillPayment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -479,6 +2483,42 @@ func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() {
 			}
 		}
 		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewBillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NewBillResponse); i {
 			case 0:
 				return &v.state
@@ -490,19 +2530,204 @@ func file_dolgovnya_split_the_bill_v1_split_the_bill_proto_init() {
 				return nil
 			}
 		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBillsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBillsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBillResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBillResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillItemChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*BillAdjustment_Percent)(nil),
+		(*BillAdjustment_Amount)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dolgovnya_split_the_bill_v1_split_the_bill_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_dolgovnya_split_the_bill_v1_split_the_bill_proto_goTypes,
 		DependencyIndexes: file_dolgovnya_split_the_bill_v1_split_the_bill_proto_depIdxs,
+		EnumInfos:         file_dolgovnya_split_the_bill_v1_split_the_bill_proto_enumTypes,
 		MessageInfos:      file_dolgovnya_split_the_bill_v1_split_the_bill_proto_msgTypes,
 	}.Build()
 	File_dolgovnya_split_the_bill_v1_split_the_bill_proto = out.File
@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SplitTheBillService_NewBill_FullMethodName = "/dolgovnya.split_the_bill.v1.SplitTheBillService/NewBill"
+	SplitTheBillService_NewBill_FullMethodName        = "/dolgovnya.split_the_bill.v1.SplitTheBillService/NewBill"
+	SplitTheBillService_GetBill_FullMethodName        = "/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBill"
+	SplitTheBillService_ListBills_FullMethodName      = "/dolgovnya.split_the_bill.v1.SplitTheBillService/ListBills"
+	SplitTheBillService_DeleteBill_FullMethodName     = "/dolgovnya.split_the_bill.v1.SplitTheBillService/DeleteBill"
+	SplitTheBillService_UpdateBill_FullMethodName     = "/dolgovnya.split_the_bill.v1.SplitTheBillService/UpdateBill"
+	SplitTheBillService_GetBillHistory_FullMethodName = "/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBillHistory"
 )
 
 // SplitTheBillServiceClient is the client API for SplitTheBillService service.
@@ -27,6 +32,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SplitTheBillServiceClient interface {
 	NewBill(ctx context.Context, in *NewBillRequest, opts ...grpc.CallOption) (*NewBillResponse, error)
+	GetBill(ctx context.Context, in *GetBillRequest, opts ...grpc.CallOption) (*GetBillResponse, error)
+	ListBills(ctx context.Context, in *ListBillsRequest, opts ...grpc.CallOption) (*ListBillsResponse, error)
+	DeleteBill(ctx context.Context, in *DeleteBillRequest, opts ...grpc.CallOption) (*DeleteBillResponse, error)
+	// Старые проводки счёта сторнируются, новые проводятся в одной транзакции.
+	UpdateBill(ctx context.Context, in *UpdateBillRequest, opts ...grpc.CallOption) (*UpdateBillResponse, error)
+	GetBillHistory(ctx context.Context, in *GetBillHistoryRequest, opts ...grpc.CallOption) (*GetBillHistoryResponse, error)
 }
 
 type splitTheBillServiceClient struct {
@@ -46,11 +57,62 @@ func (c *splitTheBillServiceClient) NewBill(ctx context.Context, in *NewBillRequ
 	return out, nil
 }
 
+func (c *splitTheBillServiceClient) GetBill(ctx context.Context, in *GetBillRequest, opts ...grpc.CallOption) (*GetBillResponse, error) {
+	out := new(GetBillResponse)
+	err := c.cc.Invoke(ctx, SplitTheBillService_GetBill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *splitTheBillServiceClient) ListBills(ctx context.Context, in *ListBillsRequest, opts ...grpc.CallOption) (*ListBillsResponse, error) {
+	out := new(ListBillsResponse)
+	err := c.cc.Invoke(ctx, SplitTheBillService_ListBills_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *splitTheBillServiceClient) DeleteBill(ctx context.Context, in *DeleteBillRequest, opts ...grpc.CallOption) (*DeleteBillResponse, error) {
+	out := new(DeleteBillResponse)
+	err := c.cc.Invoke(ctx, SplitTheBillService_DeleteBill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *splitTheBillServiceClient) UpdateBill(ctx context.Context, in *UpdateBillRequest, opts ...grpc.CallOption) (*UpdateBillResponse, error) {
+	out := new(UpdateBillResponse)
+	err := c.cc.Invoke(ctx, SplitTheBillService_UpdateBill_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *splitTheBillServiceClient) GetBillHistory(ctx context.Context, in *GetBillHistoryRequest, opts ...grpc.CallOption) (*GetBillHistoryResponse, error) {
+	out := new(GetBillHistoryResponse)
+	err := c.cc.Invoke(ctx, SplitTheBillService_GetBillHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SplitTheBillServiceServer is the server API for SplitTheBillService service.
 // All implementations must embed UnimplementedSplitTheBillServiceServer
 // for forward compatibility
 type SplitTheBillServiceServer interface {
 	NewBill(context.Context, *NewBillRequest) (*NewBillResponse, error)
+	GetBill(context.Context, *GetBillRequest) (*GetBillResponse, error)
+	ListBills(context.Context, *ListBillsRequest) (*ListBillsResponse, error)
+	DeleteBill(context.Context, *DeleteBillRequest) (*DeleteBillResponse, error)
+	// Старые проводки счёта сторнируются, новые проводятся в одной транзакции.
+	UpdateBill(context.Context, *UpdateBillRequest) (*UpdateBillResponse, error)
+	GetBillHistory(context.Context, *GetBillHistoryRequest) (*GetBillHistoryResponse, error)
 	mustEmbedUnimplementedSplitTheBillServiceServer()
 }
 
@@ -61,6 +123,21 @@ type UnimplementedSplitTheBillServiceServer struct {
 func (UnimplementedSplitTheBillServiceServer) NewBill(context.Context, *NewBillRequest) (*NewBillResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewBill not implemented")
 }
+func (UnimplementedSplitTheBillServiceServer) GetBill(context.Context, *GetBillRequest) (*GetBillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBill not implemented")
+}
+func (UnimplementedSplitTheBillServiceServer) ListBills(context.Context, *ListBillsRequest) (*ListBillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBills not implemented")
+}
+func (UnimplementedSplitTheBillServiceServer) DeleteBill(context.Context, *DeleteBillRequest) (*DeleteBillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBill not implemented")
+}
+func (UnimplementedSplitTheBillServiceServer) UpdateBill(context.Context, *UpdateBillRequest) (*UpdateBillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBill not implemented")
+}
+func (UnimplementedSplitTheBillServiceServer) GetBillHistory(context.Context, *GetBillHistoryRequest) (*GetBillHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBillHistory not implemented")
+}
 func (UnimplementedSplitTheBillServiceServer) mustEmbedUnimplementedSplitTheBillServiceServer() {}
 
 // UnsafeSplitTheBillServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +169,96 @@ func _SplitTheBillService_NewBill_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SplitTheBillService_GetBill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SplitTheBillServiceServer).GetBill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SplitTheBillService_GetBill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SplitTheBillServiceServer).GetBill(ctx, req.(*GetBillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SplitTheBillService_ListBills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SplitTheBillServiceServer).ListBills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SplitTheBillService_ListBills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SplitTheBillServiceServer).ListBills(ctx, req.(*ListBillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SplitTheBillService_DeleteBill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SplitTheBillServiceServer).DeleteBill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SplitTheBillService_DeleteBill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SplitTheBillServiceServer).DeleteBill(ctx, req.(*DeleteBillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SplitTheBillService_UpdateBill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SplitTheBillServiceServer).UpdateBill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SplitTheBillService_UpdateBill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SplitTheBillServiceServer).UpdateBill(ctx, req.(*UpdateBillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SplitTheBillService_GetBillHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBillHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SplitTheBillServiceServer).GetBillHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SplitTheBillService_GetBillHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SplitTheBillServiceServer).GetBillHistory(ctx, req.(*GetBillHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SplitTheBillService_ServiceDesc is the grpc.ServiceDesc for SplitTheBillService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +270,26 @@ var SplitTheBillService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewBill",
 			Handler:    _SplitTheBillService_NewBill_Handler,
 		},
+		{
+			MethodName: "GetBill",
+			Handler:    _SplitTheBillService_GetBill_Handler,
+		},
+		{
+			MethodName: "ListBills",
+			Handler:    _SplitTheBillService_ListBills_Handler,
+		},
+		{
+			MethodName: "DeleteBill",
+			Handler:    _SplitTheBillService_DeleteBill_Handler,
+		},
+		{
+			MethodName: "UpdateBill",
+			Handler:    _SplitTheBillService_UpdateBill_Handler,
+		},
+		{
+			MethodName: "GetBillHistory",
+			Handler:    _SplitTheBillService_GetBillHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dolgovnya/split_the_bill/v1/split_the_bill.proto",
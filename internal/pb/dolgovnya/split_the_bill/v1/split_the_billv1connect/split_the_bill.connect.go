@@ -29,6 +29,12 @@ const (
 // service.
 type SplitTheBillServiceClient interface {
 	NewBill(context.Context, *connect_go.Request[v1.NewBillRequest]) (*connect_go.Response[v1.NewBillResponse], error)
+	GetBill(context.Context, *connect_go.Request[v1.GetBillRequest]) (*connect_go.Response[v1.GetBillResponse], error)
+	ListBills(context.Context, *connect_go.Request[v1.ListBillsRequest]) (*connect_go.Response[v1.ListBillsResponse], error)
+	DeleteBill(context.Context, *connect_go.Request[v1.DeleteBillRequest]) (*connect_go.Response[v1.DeleteBillResponse], error)
+	// Старые проводки счёта сторнируются, новые проводятся в одной транзакции.
+	UpdateBill(context.Context, *connect_go.Request[v1.UpdateBillRequest]) (*connect_go.Response[v1.UpdateBillResponse], error)
+	GetBillHistory(context.Context, *connect_go.Request[v1.GetBillHistoryRequest]) (*connect_go.Response[v1.GetBillHistoryResponse], error)
 }
 
 // NewSplitTheBillServiceClient constructs a client for the
@@ -47,12 +53,42 @@ func NewSplitTheBillServiceClient(httpClient connect_go.HTTPClient, baseURL stri
 			baseURL+"/dolgovnya.split_the_bill.v1.SplitTheBillService/NewBill",
 			opts...,
 		),
+		getBill: connect_go.NewClient[v1.GetBillRequest, v1.GetBillResponse](
+			httpClient,
+			baseURL+"/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBill",
+			opts...,
+		),
+		listBills: connect_go.NewClient[v1.ListBillsRequest, v1.ListBillsResponse](
+			httpClient,
+			baseURL+"/dolgovnya.split_the_bill.v1.SplitTheBillService/ListBills",
+			opts...,
+		),
+		deleteBill: connect_go.NewClient[v1.DeleteBillRequest, v1.DeleteBillResponse](
+			httpClient,
+			baseURL+"/dolgovnya.split_the_bill.v1.SplitTheBillService/DeleteBill",
+			opts...,
+		),
+		updateBill: connect_go.NewClient[v1.UpdateBillRequest, v1.UpdateBillResponse](
+			httpClient,
+			baseURL+"/dolgovnya.split_the_bill.v1.SplitTheBillService/UpdateBill",
+			opts...,
+		),
+		getBillHistory: connect_go.NewClient[v1.GetBillHistoryRequest, v1.GetBillHistoryResponse](
+			httpClient,
+			baseURL+"/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBillHistory",
+			opts...,
+		),
 	}
 }
 
 // splitTheBillServiceClient implements SplitTheBillServiceClient.
 type splitTheBillServiceClient struct {
-	newBill *connect_go.Client[v1.NewBillRequest, v1.NewBillResponse]
+	newBill        *connect_go.Client[v1.NewBillRequest, v1.NewBillResponse]
+	getBill        *connect_go.Client[v1.GetBillRequest, v1.GetBillResponse]
+	listBills      *connect_go.Client[v1.ListBillsRequest, v1.ListBillsResponse]
+	deleteBill     *connect_go.Client[v1.DeleteBillRequest, v1.DeleteBillResponse]
+	updateBill     *connect_go.Client[v1.UpdateBillRequest, v1.UpdateBillResponse]
+	getBillHistory *connect_go.Client[v1.GetBillHistoryRequest, v1.GetBillHistoryResponse]
 }
 
 // NewBill calls dolgovnya.split_the_bill.v1.SplitTheBillService.NewBill.
@@ -60,10 +96,41 @@ func (c *splitTheBillServiceClient) NewBill(ctx context.Context, req *connect_go
 	return c.newBill.CallUnary(ctx, req)
 }
 
+// GetBill calls dolgovnya.split_the_bill.v1.SplitTheBillService.GetBill.
+func (c *splitTheBillServiceClient) GetBill(ctx context.Context, req *connect_go.Request[v1.GetBillRequest]) (*connect_go.Response[v1.GetBillResponse], error) {
+	return c.getBill.CallUnary(ctx, req)
+}
+
+// ListBills calls dolgovnya.split_the_bill.v1.SplitTheBillService.ListBills.
+func (c *splitTheBillServiceClient) ListBills(ctx context.Context, req *connect_go.Request[v1.ListBillsRequest]) (*connect_go.Response[v1.ListBillsResponse], error) {
+	return c.listBills.CallUnary(ctx, req)
+}
+
+// DeleteBill calls dolgovnya.split_the_bill.v1.SplitTheBillService.DeleteBill.
+func (c *splitTheBillServiceClient) DeleteBill(ctx context.Context, req *connect_go.Request[v1.DeleteBillRequest]) (*connect_go.Response[v1.DeleteBillResponse], error) {
+	return c.deleteBill.CallUnary(ctx, req)
+}
+
+// UpdateBill calls dolgovnya.split_the_bill.v1.SplitTheBillService.UpdateBill.
+func (c *splitTheBillServiceClient) UpdateBill(ctx context.Context, req *connect_go.Request[v1.UpdateBillRequest]) (*connect_go.Response[v1.UpdateBillResponse], error) {
+	return c.updateBill.CallUnary(ctx, req)
+}
+
+// GetBillHistory calls dolgovnya.split_the_bill.v1.SplitTheBillService.GetBillHistory.
+func (c *splitTheBillServiceClient) GetBillHistory(ctx context.Context, req *connect_go.Request[v1.GetBillHistoryRequest]) (*connect_go.Response[v1.GetBillHistoryResponse], error) {
+	return c.getBillHistory.CallUnary(ctx, req)
+}
+
 // SplitTheBillServiceHandler is an implementation of the
 // dolgovnya.split_the_bill.v1.SplitTheBillService service.
 type SplitTheBillServiceHandler interface {
 	NewBill(context.Context, *connect_go.Request[v1.NewBillRequest]) (*connect_go.Response[v1.NewBillResponse], error)
+	GetBill(context.Context, *connect_go.Request[v1.GetBillRequest]) (*connect_go.Response[v1.GetBillResponse], error)
+	ListBills(context.Context, *connect_go.Request[v1.ListBillsRequest]) (*connect_go.Response[v1.ListBillsResponse], error)
+	DeleteBill(context.Context, *connect_go.Request[v1.DeleteBillRequest]) (*connect_go.Response[v1.DeleteBillResponse], error)
+	// Старые проводки счёта сторнируются, новые проводятся в одной транзакции.
+	UpdateBill(context.Context, *connect_go.Request[v1.UpdateBillRequest]) (*connect_go.Response[v1.UpdateBillResponse], error)
+	GetBillHistory(context.Context, *connect_go.Request[v1.GetBillHistoryRequest]) (*connect_go.Response[v1.GetBillHistoryResponse], error)
 }
 
 // NewSplitTheBillServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -78,6 +145,31 @@ func NewSplitTheBillServiceHandler(svc SplitTheBillServiceHandler, opts ...conne
 		svc.NewBill,
 		opts...,
 	))
+	mux.Handle("/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBill", connect_go.NewUnaryHandler(
+		"/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBill",
+		svc.GetBill,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.split_the_bill.v1.SplitTheBillService/ListBills", connect_go.NewUnaryHandler(
+		"/dolgovnya.split_the_bill.v1.SplitTheBillService/ListBills",
+		svc.ListBills,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.split_the_bill.v1.SplitTheBillService/DeleteBill", connect_go.NewUnaryHandler(
+		"/dolgovnya.split_the_bill.v1.SplitTheBillService/DeleteBill",
+		svc.DeleteBill,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.split_the_bill.v1.SplitTheBillService/UpdateBill", connect_go.NewUnaryHandler(
+		"/dolgovnya.split_the_bill.v1.SplitTheBillService/UpdateBill",
+		svc.UpdateBill,
+		opts...,
+	))
+	mux.Handle("/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBillHistory", connect_go.NewUnaryHandler(
+		"/dolgovnya.split_the_bill.v1.SplitTheBillService/GetBillHistory",
+		svc.GetBillHistory,
+		opts...,
+	))
 	return "/dolgovnya.split_the_bill.v1.SplitTheBillService/", mux
 }
 
@@ -87,3 +179,23 @@ type UnimplementedSplitTheBillServiceHandler struct{}
 func (UnimplementedSplitTheBillServiceHandler) NewBill(context.Context, *connect_go.Request[v1.NewBillRequest]) (*connect_go.Response[v1.NewBillResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.split_the_bill.v1.SplitTheBillService.NewBill is not implemented"))
 }
+
+func (UnimplementedSplitTheBillServiceHandler) GetBill(context.Context, *connect_go.Request[v1.GetBillRequest]) (*connect_go.Response[v1.GetBillResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.split_the_bill.v1.SplitTheBillService.GetBill is not implemented"))
+}
+
+func (UnimplementedSplitTheBillServiceHandler) ListBills(context.Context, *connect_go.Request[v1.ListBillsRequest]) (*connect_go.Response[v1.ListBillsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.split_the_bill.v1.SplitTheBillService.ListBills is not implemented"))
+}
+
+func (UnimplementedSplitTheBillServiceHandler) DeleteBill(context.Context, *connect_go.Request[v1.DeleteBillRequest]) (*connect_go.Response[v1.DeleteBillResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.split_the_bill.v1.SplitTheBillService.DeleteBill is not implemented"))
+}
+
+func (UnimplementedSplitTheBillServiceHandler) UpdateBill(context.Context, *connect_go.Request[v1.UpdateBillRequest]) (*connect_go.Response[v1.UpdateBillResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.split_the_bill.v1.SplitTheBillService.UpdateBill is not implemented"))
+}
+
+func (UnimplementedSplitTheBillServiceHandler) GetBillHistory(context.Context, *connect_go.Request[v1.GetBillHistoryRequest]) (*connect_go.Response[v1.GetBillHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dolgovnya.split_the_bill.v1.SplitTheBillService.GetBillHistory is not implemented"))
+}
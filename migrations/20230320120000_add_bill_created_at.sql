@@ -0,0 +1,17 @@
+-- Время создания счёта, для фильтрации списка счетов --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE accounting_split_the_bill
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX accounting_split_the_bill_user_id_idx ON accounting_split_the_bill (user_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX accounting_split_the_bill_user_id_idx;
+
+ALTER TABLE accounting_split_the_bill
+    DROP COLUMN created_at;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- Погашение долгов вне приложения --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE owner_objects
+    ADD COLUMN kind TEXT NOT NULL DEFAULT 'split_the_bill';
+
+-- Для новых записей вид указываем явно.
+ALTER TABLE owner_objects
+    ALTER COLUMN kind DROP DEFAULT;
+
+CREATE TABLE accounting_settlements (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id BIGINT NOT NULL,
+    owning_object_id BIGINT NOT NULL,
+    FOREIGN KEY (owning_object_id, user_id) REFERENCES owner_objects(id, user_id) ON DELETE CASCADE ON UPDATE CASCADE,
+
+    payer_id BIGINT NOT NULL REFERENCES users(id),
+    payee_id BIGINT NOT NULL REFERENCES users(id),
+    amount DECIMAL(14,2) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT no_self_settlement CHECK (payer_id != payee_id)
+);
+
+CREATE INDEX accounting_settlements_payer_id_idx ON accounting_settlements (payer_id, id);
+CREATE INDEX accounting_settlements_payee_id_idx ON accounting_settlements (payee_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM owner_objects WHERE kind = 'settlement';
+
+DROP TABLE accounting_settlements;
+
+ALTER TABLE owner_objects
+    DROP COLUMN kind;
+-- +goose StatementEnd
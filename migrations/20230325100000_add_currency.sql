@@ -0,0 +1,50 @@
+-- Мультивалютность: валюта у проводок и погашений --
+
+-- +goose Up
+-- +goose StatementBegin
+-- Всё, что записано до этого, - в рублях.
+ALTER TABLE accounting_entries
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$');
+
+ALTER TABLE accounting_entries
+    ALTER COLUMN currency DROP DEFAULT;
+
+ALTER TABLE accounting_settlements
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$');
+
+ALTER TABLE accounting_settlements
+    ALTER COLUMN currency DROP DEFAULT;
+
+-- Валюты с тремя знаками после запятой (KWD, BHD, ...).
+ALTER TABLE accounting_entries
+    ALTER COLUMN amount TYPE DECIMAL(18,3);
+
+ALTER TABLE accounting_settlements
+    ALTER COLUMN amount TYPE DECIMAL(18,3);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Без колонки валюты нерублёвые суммы стали бы рублёвыми. Удалять их из
+-- учёта откат схемы не должен, поэтому откат отказывается работать.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM accounting_entries WHERE currency <> 'RUB')
+        OR EXISTS (SELECT 1 FROM accounting_settlements WHERE currency <> 'RUB') THEN
+        RAISE EXCEPTION 'non-RUB entries or settlements exist, remove them before rolling back';
+    END IF;
+END
+$$;
+
+ALTER TABLE accounting_settlements
+    ALTER COLUMN amount TYPE DECIMAL(14,2);
+
+ALTER TABLE accounting_entries
+    ALTER COLUMN amount TYPE DECIMAL(14,2);
+
+ALTER TABLE accounting_settlements
+    DROP COLUMN currency;
+
+ALTER TABLE accounting_entries
+    DROP COLUMN currency;
+-- +goose StatementEnd
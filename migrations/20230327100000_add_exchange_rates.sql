@@ -0,0 +1,19 @@
+-- Курсы валют на дату --
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE exchange_rates (
+    base CHAR(3) NOT NULL CHECK (base ~ '^[A-Z]{3}$'),
+    quote CHAR(3) NOT NULL CHECK (quote ~ '^[A-Z]{3}$'),
+    rate_date DATE NOT NULL,
+    -- 1 base = rate quote
+    rate DECIMAL(24,10) NOT NULL CHECK (rate > 0),
+    PRIMARY KEY (base, quote, rate_date),
+    CONSTRAINT no_self_rate CHECK (base != quote)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE exchange_rates;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- Редактирование счёта: ревизии и оптимистическая блокировка --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE accounting_split_the_bill
+    ADD COLUMN revision INTEGER NOT NULL DEFAULT 1 CHECK (revision > 0);
+
+-- Все версии счёта, включая текущую.
+CREATE TABLE accounting_split_the_bill_revisions (
+    bill_id BIGINT NOT NULL REFERENCES accounting_split_the_bill(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    -- Кто сохранил эту версию.
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    schema_version INTEGER NOT NULL,
+    bill jsonb NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (bill_id, revision)
+);
+
+-- Первая версия существующих счетов.
+INSERT INTO accounting_split_the_bill_revisions (bill_id, revision, user_id, schema_version, bill, created_at)
+SELECT id, 1, user_id, schema_version, bill, created_at
+FROM accounting_split_the_bill
+WHERE bill IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE accounting_split_the_bill_revisions;
+
+ALTER TABLE accounting_split_the_bill
+    DROP COLUMN revision;
+-- +goose StatementEnd
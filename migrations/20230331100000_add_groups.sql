@@ -0,0 +1,38 @@
+-- Группы: общие счета квартиры, поездки и т.п. --
+
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE groups (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    title TEXT NOT NULL CHECK (LENGTH(title) > 0),
+    created_by BIGINT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE group_members (
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX group_members_user_id_idx ON group_members (user_id);
+
+-- Счета и погашения группы. NULL - личные.
+ALTER TABLE owner_objects
+    ADD COLUMN group_id BIGINT REFERENCES groups(id);
+
+CREATE INDEX owner_objects_group_id_idx ON owner_objects (group_id) WHERE group_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Счета и погашения групп остаются в учёте как личные.
+ALTER TABLE owner_objects
+    DROP COLUMN group_id;
+
+DROP TABLE group_members;
+
+DROP TABLE groups;
+-- +goose StatementEnd
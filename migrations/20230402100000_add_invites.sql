@@ -0,0 +1,46 @@
+-- Заглушки для тех, кого ещё нет в приложении, и приглашения --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN placeholder BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN created_by BIGINT REFERENCES users(id),
+    ADD COLUMN claimed_by BIGINT REFERENCES users(id),
+    ADD CONSTRAINT placeholder_created_by CHECK (NOT placeholder OR created_by IS NOT NULL),
+    ADD CONSTRAINT claimed_only_placeholder CHECK (placeholder OR claimed_by IS NULL);
+
+-- У заглушек имена повторяются: "Петя" есть у многих.
+ALTER TABLE users
+    DROP CONSTRAINT users_title_key;
+
+CREATE UNIQUE INDEX users_title_key ON users (title) WHERE NOT placeholder;
+
+CREATE TABLE user_invites (
+    code TEXT PRIMARY KEY,
+    group_id BIGINT REFERENCES groups(id) ON DELETE CASCADE,
+    placeholder_id BIGINT REFERENCES users(id),
+    created_by BIGINT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    claimed_by BIGINT REFERENCES users(id),
+    claimed_at TIMESTAMPTZ,
+    CONSTRAINT invite_target CHECK (group_id IS NOT NULL OR placeholder_id IS NOT NULL)
+);
+
+CREATE INDEX user_invites_placeholder_id_idx ON user_invites (placeholder_id) WHERE placeholder_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_invites;
+
+DROP INDEX users_title_key;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_title_key UNIQUE (title);
+
+ALTER TABLE users
+    DROP COLUMN claimed_by,
+    DROP COLUMN created_by,
+    DROP COLUMN placeholder;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- Слияние дублей пользователей --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN merged_into BIGINT REFERENCES users(id);
+
+-- Журнал слияний: кто в кого и что перенесено.
+CREATE TABLE user_merges (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    target_user_id BIGINT NOT NULL REFERENCES users(id),
+    source_user_id BIGINT NOT NULL REFERENCES users(id),
+    report JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT no_self_merge CHECK (target_user_id != source_user_id)
+);
+
+CREATE INDEX user_merges_source_user_id_idx ON user_merges (source_user_id);
+CREATE INDEX user_merges_target_user_id_idx ON user_merges (target_user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_merges;
+
+ALTER TABLE users
+    DROP COLUMN merged_into;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- Внешние учётные записи, деактивация и поиск пользователей --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN external_provider TEXT,
+    ADD COLUMN external_subject TEXT,
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN deactivated_at TIMESTAMPTZ,
+    ADD CONSTRAINT external_identity_complete CHECK (
+        (external_provider IS NULL) = (external_subject IS NULL)
+    );
+
+CREATE UNIQUE INDEX users_external_identity_key ON users (external_provider, external_subject)
+    WHERE external_provider IS NOT NULL;
+
+-- Поиск по началу имени без учёта регистра.
+CREATE INDEX users_title_prefix_idx ON users (lower(title) text_pattern_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_title_prefix_idx;
+DROP INDEX users_external_identity_key;
+
+ALTER TABLE users
+    DROP CONSTRAINT external_identity_complete,
+    DROP COLUMN deactivated_at,
+    DROP COLUMN created_at,
+    DROP COLUMN external_subject,
+    DROP COLUMN external_provider;
+-- +goose StatementEnd
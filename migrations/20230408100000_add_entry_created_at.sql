@@ -0,0 +1,56 @@
+-- Время проводок для выписки по счёту пользователя --
+
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE owner_objects
+    ADD COLUMN created_at TIMESTAMPTZ;
+
+ALTER TABLE accounting_entries
+    ADD COLUMN created_at TIMESTAMPTZ;
+
+-- Старые объекты учёта: время создания счёта или погашения.
+UPDATE owner_objects o
+SET created_at = b.created_at
+FROM accounting_split_the_bill b
+WHERE b.owning_object_id = o.id;
+
+UPDATE owner_objects o
+SET created_at = s.created_at
+FROM accounting_settlements s
+WHERE s.owning_object_id = o.id AND o.created_at IS NULL;
+
+UPDATE owner_objects
+SET created_at = now()
+WHERE created_at IS NULL;
+
+-- Старые проводки: время объекта учёта. Сторно и проводки правок счёта,
+-- сделанных до этой миграции, получают время создания счёта. Порядок
+-- внутри объекта сохраняется по id.
+UPDATE accounting_entries e
+SET created_at = o.created_at
+FROM owner_objects o
+WHERE o.id = e.owning_object_id;
+
+ALTER TABLE owner_objects
+    ALTER COLUMN created_at SET DEFAULT now(),
+    ALTER COLUMN created_at SET NOT NULL;
+
+ALTER TABLE accounting_entries
+    ALTER COLUMN created_at SET DEFAULT now(),
+    ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX accounting_entries_user_from_created_at_idx ON accounting_entries (user_from, created_at, id);
+CREATE INDEX accounting_entries_user_to_created_at_idx ON accounting_entries (user_to, created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX accounting_entries_user_to_created_at_idx;
+DROP INDEX accounting_entries_user_from_created_at_idx;
+
+ALTER TABLE accounting_entries
+    DROP COLUMN created_at;
+
+ALTER TABLE owner_objects
+    DROP COLUMN created_at;
+-- +goose StatementEnd
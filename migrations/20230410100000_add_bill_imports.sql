@@ -0,0 +1,21 @@
+-- Счета, загруженные из CSV и Splitwise --
+
+-- +goose Up
+-- +goose StatementBegin
+-- Хэш исходной строки файла -> созданный из неё счёт. Повторная загрузка
+-- того же файла тем же владельцем пропускает строки, которые уже есть здесь.
+CREATE TABLE bill_imports (
+    owner_id BIGINT NOT NULL REFERENCES users(id),
+    source_hash TEXT NOT NULL,
+    bill_id BIGINT NOT NULL REFERENCES accounting_split_the_bill(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (owner_id, source_hash)
+);
+
+CREATE INDEX bill_imports_bill_id_idx ON bill_imports (bill_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE bill_imports;
+-- +goose StatementEnd